@@ -0,0 +1,236 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Arg Typed placeholder value, formatted according to the translator locale.
+// Args are passed inside M, e.g. `tr.Tf("cart", "total", i18n.M{"{sum}": i18n.Money(129900, "CZK")})`
+type Arg interface {
+	AppendArg(dst []byte, tr *Translator) []byte
+}
+
+// localeFormat Number, currency and date conventions of a language
+type localeFormat struct {
+	decimal       string
+	group         string
+	date          string
+	currencyAfter bool
+}
+
+var (
+	defaultLocaleFormat = &localeFormat{decimal: ".", group: ",", date: "2006-01-02"}
+
+	// localeFormats "language" => conventions
+	localeFormats = map[string]*localeFormat{
+		"en": {decimal: ".", group: ",", date: "01/02/2006"},
+		"cs": {decimal: ",", group: "\u00a0", date: "2.1.2006", currencyAfter: true},
+		"sk": {decimal: ",", group: "\u00a0", date: "2.1.2006", currencyAfter: true},
+		"de": {decimal: ",", group: ".", date: "02.01.2006", currencyAfter: true},
+		"ru": {decimal: ",", group: "\u00a0", date: "02.01.2006", currencyAfter: true},
+		"uk": {decimal: ",", group: "\u00a0", date: "02.01.2006", currencyAfter: true},
+		"pl": {decimal: ",", group: "\u00a0", date: "02.01.2006", currencyAfter: true},
+		"fr": {decimal: ",", group: "\u202f", date: "02/01/2006", currencyAfter: true},
+		"es": {decimal: ",", group: ".", date: "02/01/2006", currencyAfter: true},
+		"it": {decimal: ",", group: ".", date: "02/01/2006", currencyAfter: true},
+		"ja": {decimal: ".", group: ",", date: "2006/01/02"},
+		"zh": {decimal: ".", group: ",", date: "2006/01/02"},
+	}
+
+	// languageAliases Non ISO 639-1 names used for dictionaries, e.g. "cz"
+	languageAliases = map[string]string{
+		"cz": "cs",
+		"ua": "uk",
+	}
+
+	// currencies "ISO 4217 code" => symbol and minor units
+	currencies = map[string]struct {
+		symbol string
+		digits int
+	}{
+		"USD": {"$", 2},
+		"EUR": {"€", 2},
+		"GBP": {"£", 2},
+		"CZK": {"Kč", 2},
+		"PLN": {"zł", 2},
+		"RUB": {"₽", 2},
+		"UAH": {"₴", 2},
+		"JPY": {"¥", 0},
+		"CNY": {"¥", 2},
+	}
+)
+
+// formatFor Returns conventions for locale like "en_US", "cs-CZ" or "cz"
+func formatFor(locale string) *localeFormat {
+	if f, ok := localeFormats[languageOf(locale)]; ok {
+		return f
+	}
+	return defaultLocaleFormat
+}
+
+// languageOf Returns ISO 639-1 language of locale, e.g. "cs" for "cz" and "cs_CZ"
+func languageOf(locale string) string {
+	lang := strings.ToLower(locale)
+	if i := strings.IndexAny(lang, "_-"); i >= 0 {
+		lang = lang[:i]
+	}
+	if alias, ok := languageAliases[lang]; ok {
+		lang = alias
+	}
+	return lang
+}
+
+// localeFormat Returns translator conventions
+func (tr *Translator) localeFormat() *localeFormat {
+	if tr == nil || tr.format == nil {
+		return defaultLocaleFormat
+	}
+	return tr.format
+}
+
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+type intArg struct {
+	negative bool
+	abs      uint64
+}
+
+// Int Returns integer placeholder value with locale digit grouping, e.g. "1,234" or "1\u00a0234"
+func Int[T integer](v T) Arg {
+	if v < 0 {
+		return intArg{negative: true, abs: uint64(-int64(v))}
+	}
+	return intArg{abs: uint64(v)}
+}
+
+func (a intArg) AppendArg(dst []byte, tr *Translator) []byte {
+	if a.negative {
+		dst = append(dst, '-')
+	}
+	return appendGrouped(dst, a.abs, tr.localeFormat().group)
+}
+
+type floatArg struct {
+	value     float64
+	precision int
+}
+
+// Float Returns floating point placeholder value with locale separators
+// and fixed number of decimals
+func Float(v float64, precision int) Arg {
+	return floatArg{value: v, precision: precision}
+}
+
+func (a floatArg) AppendArg(dst []byte, tr *Translator) []byte {
+	return appendDecimal(dst, a.value, a.precision, tr.localeFormat())
+}
+
+type moneyArg struct {
+	amount   int64
+	currency string
+}
+
+// Money Returns money placeholder value, amount is given in minor units of currency,
+// e.g. `Money(129950, "EUR")` is "€1,299.50" for "en" and "1.299,50\u00a0€" for "de"
+func Money(amount int64, currency string) Arg {
+	return moneyArg{amount: amount, currency: currency}
+}
+
+func (a moneyArg) AppendArg(dst []byte, tr *Translator) []byte {
+	f := tr.localeFormat()
+	symbol, digits := a.currency, 2
+	if c, ok := currencies[a.currency]; ok {
+		symbol, digits = c.symbol, c.digits
+	}
+
+	amount := a.amount
+	if amount < 0 {
+		dst = append(dst, '-')
+		amount = -amount
+	}
+	if !f.currencyAfter {
+		dst = append(dst, symbol...)
+	}
+
+	unit := uint64(1)
+	for i := 0; i < digits; i++ {
+		unit *= 10
+	}
+	dst = appendGrouped(dst, uint64(amount)/unit, f.group)
+	if digits > 0 {
+		dst = append(dst, f.decimal...)
+		dst = appendPadded(dst, uint64(amount)%unit, digits)
+	}
+
+	if f.currencyAfter {
+		dst = append(dst, "\u00a0"...)
+		dst = append(dst, symbol...)
+	}
+	return dst
+}
+
+type dateArg time.Time
+
+// Date Returns date placeholder value in locale date layout, e.g. "01/02/2006" or "2.1.2006"
+func Date(t time.Time) Arg {
+	return dateArg(t)
+}
+
+func (a dateArg) AppendArg(dst []byte, tr *Translator) []byte {
+	return time.Time(a).AppendFormat(dst, tr.localeFormat().date)
+}
+
+// appendGrouped Appends v with group separator between thousands
+func appendGrouped(dst []byte, v uint64, group string) []byte {
+	var tmp [20]byte
+	digits := strconv.AppendUint(tmp[:0], v, 10)
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			dst = append(dst, group...)
+		}
+		dst = append(dst, d)
+	}
+	return dst
+}
+
+// appendPadded Appends v padded with leading zeros to width
+func appendPadded(dst []byte, v uint64, width int) []byte {
+	var tmp [20]byte
+	digits := strconv.AppendUint(tmp[:0], v, 10)
+	for i := len(digits); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, digits...)
+}
+
+// appendDecimal Appends v with locale separators and precision decimals
+func appendDecimal(dst []byte, v float64, precision int, f *localeFormat) []byte {
+	var tmp [64]byte
+	digits := strconv.AppendFloat(tmp[:0], v, 'f', precision, 64)
+	if len(digits) > 0 && digits[0] == '-' {
+		dst = append(dst, '-')
+		digits = digits[1:]
+	}
+	intPart, fracPart := digits, []byte(nil)
+	for i, d := range digits {
+		if d == '.' {
+			intPart, fracPart = digits[:i], digits[i+1:]
+			break
+		}
+	}
+	for i, d := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			dst = append(dst, f.group...)
+		}
+		dst = append(dst, d)
+	}
+	if len(fracPart) > 0 {
+		dst = append(dst, f.decimal...)
+		dst = append(dst, fracPart...)
+	}
+	return dst
+}
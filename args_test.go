@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+)
+
+func TestArgs_Tf(t *testing.T) {
+	dict := &Dictionary{
+		"cart": {
+			"items": "{count} items",
+			"total": "Total {sum}",
+			"ratio": "Ratio {ratio}",
+			"until": "Valid until {date}",
+			"hint":  "{product}: {hint}",
+		},
+		"product": {
+			"name": "Acme Cloud",
+		},
+	}
+	en := testTranslator(t, "en_US", dict)
+	cs := testTranslator(t, "cz", dict)
+	de := testTranslator(t, "de-DE", dict)
+	date := time.Date(2024, time.March, 7, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		tr     *Translator
+		key    string
+		values M
+		want   string
+	}{
+		{
+			name:   "int en",
+			tr:     en,
+			key:    "items",
+			values: M{"{count}": Int(1234567)},
+			want:   "1,234,567 items",
+		},
+		{
+			name:   "negative int cs",
+			tr:     cs,
+			key:    "items",
+			values: M{"{count}": Int(int16(-1234))},
+			want:   "-1\u00a0234 items",
+		},
+		{
+			name:   "money en",
+			tr:     en,
+			key:    "total",
+			values: M{"{sum}": Money(129950, "EUR")},
+			want:   "Total €1,299.50",
+		},
+		{
+			name:   "money de",
+			tr:     de,
+			key:    "total",
+			values: M{"{sum}": Money(129905, "EUR")},
+			want:   "Total 1.299,05\u00a0€",
+		},
+		{
+			name:   "money without minor units",
+			tr:     cs,
+			key:    "total",
+			values: M{"{sum}": Money(1500, "JPY")},
+			want:   "Total 1\u00a0500\u00a0¥",
+		},
+		{
+			name:   "float cs",
+			tr:     cs,
+			key:    "ratio",
+			values: M{"{ratio}": Float(1234.5, 2)},
+			want:   "Ratio 1\u00a0234,50",
+		},
+		{
+			name:   "date en",
+			tr:     en,
+			key:    "until",
+			values: M{"{date}": Date(date)},
+			want:   "Valid until 03/07/2024",
+		},
+		{
+			name:   "date cs",
+			tr:     cs,
+			key:    "until",
+			values: M{"{date}": Date(date)},
+			want:   "Valid until 7.3.2024",
+		},
+		{
+			name:   "nested message",
+			tr:     en,
+			key:    "hint",
+			values: M{"{product}": Msg("product", "name"), "{hint}": Msg("cart", "items", M{"{count}": Int(2)})},
+			want:   "Acme Cloud: 2 items",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.Tf("cart", tt.key, tt.values); got != tt.want {
+				t.Errorf("Translator.Tf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
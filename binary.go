@@ -0,0 +1,363 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// Binary dictionaries format, all integers are little endian uint32 unless noted.
+//
+//	header:  "I18N", version (uint16), reserved (uint16), locales count, entries count,
+//	         payload length, CRC-32 (IEEE) of payload
+//	payload: locales table, locales count × {name offset, name length, first entry, entries count}
+//	         entries table, entries count × {section offset, section length, key offset, key length,
+//	         value offset, value length}, entries of each locale are sorted by section and key
+//	         strings blob, offsets of tables point into it
+const (
+	binaryMagic      = "I18N"
+	binaryVersion    = 1
+	binaryHeaderSize = 24
+	binaryLocaleSize = 16
+	binaryEntrySize  = 24
+)
+
+// WriteBinary Writes collection in binary format, dictionaries are validated as in Init
+func WriteBinary(w io.Writer, collection *DictionaryCollection) error {
+	if collection == nil {
+		return errors.New("collection not set")
+	}
+
+	locales := collection.getLocales()
+	sort.Strings(locales)
+
+	var (
+		blob       bytes.Buffer
+		strOffsets = map[string]uint32{}
+		localeTbl  []byte
+		entryTbl   []byte
+		entries    uint32
+	)
+	str := func(s string) []byte {
+		off, ok := strOffsets[s]
+		if !ok {
+			off = uint32(blob.Len())
+			strOffsets[s] = off
+			blob.WriteString(s)
+		}
+		var b [8]byte
+		binary.LittleEndian.PutUint32(b[:4], off)
+		binary.LittleEndian.PutUint32(b[4:], uint32(len(s)))
+		return b[:]
+	}
+
+	for _, locale := range locales {
+		dict := (*collection)[locale]
+		if _, err := compileDictionary(dict); err != nil {
+			return fmt.Errorf("locale %s: %w", locale, err)
+		}
+
+		type entry struct{ section, key, value string }
+		var list []entry
+		if dict != nil {
+			for section, sectionEntry := range *dict {
+				if sectionEntry == nil {
+					continue
+				}
+				for key, value := range *sectionEntry {
+					list = append(list, entry{section, key, value})
+				}
+			}
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].section != list[j].section {
+				return list[i].section < list[j].section
+			}
+			return list[i].key < list[j].key
+		})
+
+		localeTbl = append(localeTbl, str(locale)...)
+		localeTbl = binary.LittleEndian.AppendUint32(localeTbl, entries)
+		localeTbl = binary.LittleEndian.AppendUint32(localeTbl, uint32(len(list)))
+		for _, e := range list {
+			entryTbl = append(entryTbl, str(e.section)...)
+			entryTbl = append(entryTbl, str(e.key)...)
+			entryTbl = append(entryTbl, str(e.value)...)
+		}
+		entries += uint32(len(list))
+	}
+
+	payload := make([]byte, 0, len(localeTbl)+len(entryTbl)+blob.Len())
+	payload = append(payload, localeTbl...)
+	payload = append(payload, entryTbl...)
+	payload = append(payload, blob.Bytes()...)
+
+	header := make([]byte, 0, binaryHeaderSize)
+	header = append(header, binaryMagic...)
+	header = binary.LittleEndian.AppendUint16(header, binaryVersion)
+	header = binary.LittleEndian.AppendUint16(header, 0)
+	header = binary.LittleEndian.AppendUint32(header, uint32(len(locales)))
+	header = binary.LittleEndian.AppendUint32(header, entries)
+	header = binary.LittleEndian.AppendUint32(header, uint32(len(payload)))
+	header = binary.LittleEndian.AppendUint32(header, crc32.ChecksumIEEE(payload))
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// InitFromBinary Initialize translator with memory mapped binary dictionaries file,
+// written by WriteBinary or "i18n-compile" tool. Entries are served from the mapping
+// without copying, so the file must not be changed while in use, replace it with a new file instead.
+// Translated strings point into the mapping and may outlive translators, so mapping is kept
+// until the process exits, each call maps the file again. Use InitFromBinaryData with file content
+// for frequent reloads.
+func InitFromBinary(defaultLocale, path string, locales ...string) error {
+	data, err := mapFile(path)
+	if err != nil {
+		return err
+	}
+	if err = InitFromBinaryData(defaultLocale, data, locales...); err != nil {
+		// Nothing points into mapping of rejected file
+		unmapFile(data)
+		return err
+	}
+	return nil
+}
+
+// InitFromBinaryData Initialize translator with binary dictionaries, e.g. embedded with go:embed.
+// Data must not be changed after the call.
+func InitFromBinaryData(defaultLocale string, data []byte, locales ...string) error {
+	file, err := parseBinary(data)
+	if err != nil {
+		return err
+	}
+
+	if len(locales) == 0 {
+		for i := range file.locales {
+			locales = append(locales, file.locales[i].name)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	snap := &snapshot{
+		defLocale:        defaultLocale,
+		availableLocales: locales,
+		translators:      make(TranslatorCollection),
+	}
+	for _, locale := range locales {
+		bl, ok := file.locale(locale)
+		if !ok {
+			return fmt.Errorf("no binary dictionary for locale %s", locale)
+		}
+		snap.translators[locale] = &Translator{
+			locale:   locale,
+			format:   formatFor(locale),
+			messages: catalog{},
+			binary:   bl,
+		}
+	}
+	if _, ok := snap.translators[defaultLocale]; !ok {
+		return errors.New("no dictionary for default language")
+	}
+	if err = snap.applyOverlays(load()); err != nil {
+		return err
+	}
+
+	current.Store(snap)
+	return nil
+}
+
+// binaryFile Parsed binary dictionaries
+type binaryFile struct {
+	data    []byte
+	entries []byte
+	blob    []byte
+	locales []*binaryLocale
+}
+
+// binaryLocale Sorted entries of locale, compiled messages are cached per entry
+type binaryLocale struct {
+	file     *binaryFile
+	name     string
+	first    uint32
+	count    uint32
+	messages []atomic.Pointer[message]
+
+	refsOnce sync.Once
+	refs     []int
+}
+
+func parseBinary(data []byte) (*binaryFile, error) {
+	if len(data) < binaryHeaderSize || string(data[:4]) != binaryMagic {
+		return nil, errors.New("invalid binary dictionaries header")
+	}
+	if version := binary.LittleEndian.Uint16(data[4:]); version != binaryVersion {
+		return nil, fmt.Errorf("unsupported binary dictionaries version %d", version)
+	}
+	localesCount := binary.LittleEndian.Uint32(data[8:])
+	entriesCount := binary.LittleEndian.Uint32(data[12:])
+	payloadLen := binary.LittleEndian.Uint32(data[16:])
+	checksum := binary.LittleEndian.Uint32(data[20:])
+
+	payload := data[binaryHeaderSize:]
+	if uint64(len(payload)) != uint64(payloadLen) {
+		return nil, errors.New("truncated binary dictionaries")
+	}
+	if crc32.ChecksumIEEE(payload) != checksum {
+		return nil, errors.New("binary dictionaries checksum mismatch")
+	}
+	tablesLen := uint64(localesCount)*binaryLocaleSize + uint64(entriesCount)*binaryEntrySize
+	if tablesLen > uint64(len(payload)) {
+		return nil, errors.New("truncated binary dictionaries")
+	}
+
+	entriesStart := uint64(localesCount) * binaryLocaleSize
+	f := &binaryFile{
+		data:    data,
+		entries: payload[entriesStart:tablesLen],
+		blob:    payload[tablesLen:],
+	}
+	for i := uint32(0); i < localesCount; i++ {
+		row := payload[i*binaryLocaleSize:]
+		name, err := f.str(row, 0)
+		if err != nil {
+			return nil, err
+		}
+		bl := &binaryLocale{
+			file:  f,
+			name:  name,
+			first: binary.LittleEndian.Uint32(row[8:]),
+			count: binary.LittleEndian.Uint32(row[12:]),
+		}
+		if uint64(bl.first)+uint64(bl.count) > uint64(entriesCount) {
+			return nil, errors.New("invalid binary dictionaries locale table")
+		}
+		bl.messages = make([]atomic.Pointer[message], bl.count)
+		f.locales = append(f.locales, bl)
+	}
+	for i := uint32(0); i < entriesCount; i++ {
+		row := f.entries[i*binaryEntrySize:]
+		for field := 0; field < 3; field++ {
+			if _, err := f.str(row, field*8); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return f, nil
+}
+
+// str Returns string of blob without copying, referenced by offset and length at row[at:]
+func (f *binaryFile) str(row []byte, at int) (string, error) {
+	off := uint64(binary.LittleEndian.Uint32(row[at:]))
+	n := uint64(binary.LittleEndian.Uint32(row[at+4:]))
+	if off+n > uint64(len(f.blob)) {
+		return "", errors.New("invalid binary dictionaries string offset")
+	}
+	if n == 0 {
+		return "", nil
+	}
+	return unsafe.String(&f.blob[off], n), nil
+}
+
+func (f *binaryFile) locale(name string) (*binaryLocale, bool) {
+	for _, bl := range f.locales {
+		if bl.name == name {
+			return bl, true
+		}
+	}
+	return nil, false
+}
+
+// entry Returns section, key and value of locale entry i, offsets are validated by parseBinary
+func (bl *binaryLocale) entry(i int) (section, key, value string) {
+	row := bl.file.entries[(int(bl.first)+i)*binaryEntrySize:]
+	section, _ = bl.file.str(row, 0)
+	key, _ = bl.file.str(row, 8)
+	value, _ = bl.file.str(row, 16)
+	return
+}
+
+// find Returns index of entry or -1
+func (bl *binaryLocale) find(section, key string) int {
+	n := int(bl.count)
+	i := sort.Search(n, func(i int) bool {
+		s, k, _ := bl.entry(i)
+		return s > section || (s == section && k >= key)
+	})
+	if i < n {
+		if s, k, _ := bl.entry(i); s == section && k == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// lookup Returns compiled message, entries are compiled on first use
+func (bl *binaryLocale) lookup(section, key string) (*message, bool) {
+	i := bl.find(section, key)
+	if i < 0 {
+		return nil, false
+	}
+	return bl.message(i, nil), true
+}
+
+// referencing Returns indexes of entries referencing other entries, e.g. "{@product.name}"
+func (bl *binaryLocale) referencing() []int {
+	bl.refsOnce.Do(func() {
+		for i := 0; i < int(bl.count); i++ {
+			if _, _, value := bl.entry(i); strings.Contains(value, "{@") && len(compileMessage(value).refs) > 0 {
+				bl.refs = append(bl.refs, i)
+			}
+		}
+	})
+	return bl.refs
+}
+
+// message Returns compiled entry i, visiting guards references against cycles
+func (bl *binaryLocale) message(i int, visiting []int) *message {
+	if m := bl.messages[i].Load(); m != nil {
+		return m
+	}
+	section, key, value := bl.entry(i)
+	m := compileMessage(value)
+	if len(m.refs) > 0 {
+		visiting = append(visiting, i)
+		lookup := func(section, key string) (*message, bool) {
+			j := bl.find(section, key)
+			if j < 0 {
+				return nil, false
+			}
+			for _, v := range visiting {
+				if v == j {
+					return nil, false
+				}
+			}
+			return bl.message(j, visiting), true
+		}
+		_ = resolveMessage(section+"."+key, m, lookup)
+	}
+	bl.messages[i].Store(m)
+	return m
+}
+
+// dictionary Returns dictionary with entries of locale
+func (bl *binaryLocale) dictionary() *Dictionary {
+	dict := Dictionary{}
+	for i := 0; i < int(bl.count); i++ {
+		dict.set(bl.entry(i))
+	}
+	return &dict
+}
@@ -0,0 +1,210 @@
+package i18n
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func testBinaryCollection() *DictionaryCollection {
+	return &DictionaryCollection{
+		"en": {
+			"errors.connections": {
+				"connections_limit": "Connections limit is {count}",
+			},
+			"product": {
+				"name": "Acme Cloud",
+			},
+			"support": {
+				"contact": "Contact {@product.name} support",
+				"empty":   "",
+			},
+		},
+		"cz": {
+			"errors.connections": {
+				"connections_limit": "Limit připojení je {count}",
+			},
+		},
+	}
+}
+
+func TestInitFromBinary(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteBinary(&buf, testBinaryCollection()); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "translations.bin")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := InitFromBinary("en", path); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		locale  string
+		section string
+		key     string
+		values  M
+		want    string
+	}{
+		{
+			name:    "formatted",
+			locale:  "cz",
+			section: "errors.connections",
+			key:     "connections_limit",
+			values:  M{"{count}": 50},
+			want:    "Limit připojení je 50",
+		},
+		{
+			name:    "reference",
+			locale:  "en",
+			section: "support",
+			key:     "contact",
+			want:    "Contact Acme Cloud support",
+		},
+		{
+			name:    "empty value",
+			locale:  "en",
+			section: "support",
+			key:     "empty",
+			want:    "",
+		},
+		{
+			name:    "missing entry",
+			locale:  "cz",
+			section: "product",
+			key:     "name",
+			want:    "product.name",
+		},
+		{
+			name:    "default locale",
+			locale:  "de",
+			section: "product",
+			key:     "name",
+			want:    "Acme Cloud",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Get(tt.locale).Tf(tt.section, tt.key, tt.values); got != tt.want {
+				t.Errorf("Get().Tf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if err := Set("en", "product", "name", "Acme Cloud Pro"); err != nil {
+		t.Fatal(err)
+	}
+	if got := Get("en").T("support", "contact"); got != "Contact Acme Cloud Pro support" {
+		t.Errorf("Get().T() after Set() = %v", got)
+	}
+}
+
+func TestInitFromBinary_Overlay(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteBinary(&buf, testBinaryCollection()); err != nil {
+		t.Fatal(err)
+	}
+	if err := InitFromBinaryData("en", buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	defer RemoveOverlay("tenant")
+
+	err := SetOverlay("tenant", &DictionaryCollection{
+		"en": {"product": {"name": "Tenant Cloud"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := GetFor("tenant", "en").T("support", "contact"); got != "Contact Tenant Cloud support" {
+		t.Errorf("GetFor().T() = %v, want Contact Tenant Cloud support", got)
+	}
+	if got := Get("en").T("support", "contact"); got != "Contact Acme Cloud support" {
+		t.Errorf("Get().T() = %v, want Contact Acme Cloud support", got)
+	}
+}
+
+func TestInitFromBinary_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "translations.bin")
+	if err := os.WriteFile(path, []byte("JSON{}"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := InitFromBinary("en", path); err == nil {
+		t.Error("InitFromBinary() error = nil, want error")
+	}
+	if err := InitFromBinary("en", filepath.Join(t.TempDir(), "missing.bin")); err == nil {
+		t.Error("InitFromBinary() error = nil, want missing file error")
+	}
+}
+
+func TestInitFromBinaryData_Invalid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteBinary(&buf, testBinaryCollection()); err != nil {
+		t.Fatal(err)
+	}
+	valid := buf.Bytes()
+
+	corrupted := append([]byte{}, valid...)
+	corrupted[len(corrupted)-1] ^= 0xff
+
+	version := append([]byte{}, valid...)
+	version[4] = 99
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"magic", []byte("JSON{}")},
+		{"version", version},
+		{"truncated", valid[:len(valid)-3]},
+		{"checksum", corrupted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := InitFromBinaryData("en", tt.data); err == nil {
+				t.Error("InitFromBinaryData() error = nil, want error")
+			}
+		})
+	}
+
+	if err := InitFromBinaryData("de", valid); err == nil {
+		t.Error("InitFromBinaryData() error = nil, want missing default locale")
+	}
+
+	cyclic := &DictionaryCollection{"en": {"a": {"b": "{@a.b}"}}}
+	if err := WriteBinary(&bytes.Buffer{}, cyclic); err == nil {
+		t.Error("WriteBinary() error = nil, want cyclic reference")
+	}
+}
+
+func BenchmarkInitFromBinaryData(b *testing.B) {
+	collection := DictionaryCollection{"en": {}}
+	for i := 0; i < 1000; i++ {
+		collection["en"].set("section", "key"+strconv.Itoa(i), "Translation {count}")
+	}
+	var buf bytes.Buffer
+	if err := WriteBinary(&buf, &collection); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("binary", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := InitFromBinaryData("en", buf.Bytes()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("collection", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := Init("en", &collection); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
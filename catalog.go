@@ -0,0 +1,251 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CatalogEntry Documented error of catalog
+type CatalogEntry struct {
+	// ID Stable identifier, e.g. "AUTH-0042"
+	ID string
+	// Description Meaning of error for support and API clients
+	Description string
+	// Def Error definition
+	Def ErrorDef
+}
+
+// Catalog Registry of errors emitted by service, generates documentation
+// with translations of loaded locales
+type Catalog struct {
+	mu      sync.RWMutex
+	entries map[string]*CatalogEntry
+	// keys "section.key" => entry
+	keys map[string]*CatalogEntry
+}
+
+// DefaultCatalog Catalog used by RegisterError
+var DefaultCatalog = NewCatalog()
+
+// NewCatalog Creates empty catalog
+func NewCatalog() *Catalog {
+	return &Catalog{
+		entries: make(map[string]*CatalogEntry),
+		keys:    make(map[string]*CatalogEntry),
+	}
+}
+
+// RegisterError Registers error in DefaultCatalog, see Catalog.Register
+func RegisterError(id string, code int, section string, key string, description string) ErrorDef {
+	return DefaultCatalog.Register(id, code, section, key, description)
+}
+
+// Register Registers error and returns its definition, intended for package level variables:
+//
+//	var ErrConnectionsLimit = catalog.Register("CONN-0001", http.StatusTooManyRequests,
+//		"errors.connections", "connections_limit", "Plan limit of concurrent connections is reached")
+//
+// Register panics when id or section and key are already registered
+func (c *Catalog) Register(id string, code int, section string, key string, description string) ErrorDef {
+	def := DefineWithCode(code, section, key)
+	def.id = id
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[id]; ok {
+		panic(fmt.Sprintf("i18n: error %s is already registered", id))
+	}
+	if entry, ok := c.keys[def.Error()]; ok {
+		panic(fmt.Sprintf("i18n: error %s is already registered as %s", def.Error(), entry.ID))
+	}
+	entry := &CatalogEntry{
+		ID:          id,
+		Description: description,
+		Def:         def,
+	}
+	c.entries[id] = entry
+	c.keys[def.Error()] = entry
+	return def
+}
+
+// Get Returns registered error by id
+func (c *Catalog) Get(id string) (CatalogEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if entry, ok := c.entries[id]; ok {
+		return *entry, true
+	}
+	return CatalogEntry{}, false
+}
+
+// Lookup Returns registered error of first i18n error of err chain, matched by section and key
+func (c *Catalog) Lookup(err error) (CatalogEntry, bool) {
+	var (
+		i18nErr *I18nError
+		def     ErrorDef
+	)
+	switch {
+	case errors.As(err, &i18nErr) && i18nErr.BaseError != nil:
+		return c.lookup(i18nErr.section, i18nErr.key)
+	case errors.As(err, &def):
+		return c.lookup(def.section, def.key)
+	default:
+		return CatalogEntry{}, false
+	}
+}
+
+func (c *Catalog) lookup(section string, key string) (CatalogEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if entry, ok := c.keys[Define(section, key).Error()]; ok {
+		return *entry, true
+	}
+	return CatalogEntry{}, false
+}
+
+// Entries Returns registered errors sorted by id
+func (c *Catalog) Entries() []CatalogEntry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entries := make([]CatalogEntry, 0, len(c.entries))
+	for _, entry := range c.entries {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID < entries[j].ID
+	})
+	return entries
+}
+
+// WriteMarkdown Writes Markdown documentation of registered errors with messages of locales,
+// all loaded locales are used when locales are not set
+func (c *Catalog) WriteMarkdown(w io.Writer, locales ...string) error {
+	translators, err := catalogTranslators(locales)
+	if err != nil {
+		return err
+	}
+	return c.writeMarkdown(w, translators)
+}
+
+// WriteHTML Writes HTML documentation of registered errors with messages of locales,
+// all loaded locales are used when locales are not set
+func (c *Catalog) WriteHTML(w io.Writer, locales ...string) error {
+	translators, err := catalogTranslators(locales)
+	if err != nil {
+		return err
+	}
+	return c.writeHTML(w, translators)
+}
+
+// catalogTranslators Returns translators of locales or all loaded locales
+func catalogTranslators(locales []string) ([]*Translator, error) {
+	if load() == nil {
+		return nil, errors.New("translator not initialized")
+	}
+	if len(locales) == 0 {
+		locales = AvailableLocales()
+	}
+	translators := make([]*Translator, len(locales))
+	for i, locale := range locales {
+		translators[i] = getTranslator(locale)
+	}
+	return translators, nil
+}
+
+// catalogMessage Message of entry in locale, placeholders are kept, empty when not translated
+type catalogMessage struct {
+	Locale  string
+	Message string
+}
+
+// catalogDoc Entry with messages of documented locales
+type catalogDoc struct {
+	CatalogEntry
+	Messages []catalogMessage
+}
+
+func (c *Catalog) docs(translators []*Translator) []catalogDoc {
+	entries := c.Entries()
+	docs := make([]catalogDoc, len(entries))
+	for i, entry := range entries {
+		docs[i] = catalogDoc{
+			CatalogEntry: entry,
+			Messages:     make([]catalogMessage, len(translators)),
+		}
+		for j, tr := range translators {
+			docs[i].Messages[j].Locale = tr.Locale()
+			if m, ok := tr.lookup(entry.Def.section, entry.Def.key); ok {
+				docs[i].Messages[j].Message = m.raw
+			}
+		}
+	}
+	return docs
+}
+
+func (c *Catalog) writeMarkdown(w io.Writer, translators []*Translator) error {
+	var b strings.Builder
+	b.WriteString("# Errors\n")
+	for _, doc := range c.docs(translators) {
+		b.WriteString("\n## " + doc.ID + "\n\n")
+		b.WriteString("`" + doc.Def.Error() + "`")
+		if doc.Def.code != 0 {
+			b.WriteString(", code " + strconv.Itoa(doc.Def.code))
+		}
+		b.WriteString("\n\n")
+		if doc.Description != "" {
+			b.WriteString(doc.Description + "\n\n")
+		}
+		b.WriteString("| Locale | Message |\n| --- | --- |\n")
+		for _, msg := range doc.Messages {
+			b.WriteString("| " + markdownCell(msg.Locale) + " | " + markdownCell(msg.Message) + " |\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownCell Returns text escaped for Markdown table cell
+func markdownCell(text string) string {
+	return strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>").Replace(text)
+}
+
+var catalogHTML = template.Must(template.New("catalog").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Errors</title>
+</head>
+<body>
+<h1>Errors</h1>
+{{- range .}}
+<section id="{{.ID}}">
+<h2>{{.ID}}</h2>
+<p><code>{{.Def.Error}}</code>{{if .Def.Code}}, code {{.Def.Code}}{{end}}</p>
+{{- if .Description}}
+<p>{{.Description}}</p>
+{{- end}}
+<table>
+<tr><th>Locale</th><th>Message</th></tr>
+{{- range .Messages}}
+<tr><td>{{.Locale}}</td><td>{{.Message}}</td></tr>
+{{- end}}
+</table>
+</section>
+{{- end}}
+</body>
+</html>
+`))
+
+func (c *Catalog) writeHTML(w io.Writer, translators []*Translator) error {
+	return catalogHTML.Execute(w, c.docs(translators))
+}
@@ -0,0 +1,128 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func testCatalog(t *testing.T) (*Catalog, []*Translator) {
+	t.Helper()
+	c := NewCatalog()
+	c.Register("CONN-0001", 429, "errors.connections", "connections_limit", "Plan limit of concurrent connections is reached")
+	c.Register("AUTH-0042", 401, "errors.auth", "expired", "Session token | expired")
+
+	en := testTranslator(t, "en", &Dictionary{
+		"errors.connections": {"connections_limit": "At most {limit} connections"},
+		"errors.auth":        {"expired": "Session <expired>"},
+	})
+	cs := testTranslator(t, "cs", &Dictionary{
+		"errors.auth": {"expired": "Relace vypršela"},
+	})
+	return c, []*Translator{en, cs}
+}
+
+func TestCatalog_Register(t *testing.T) {
+	c, _ := testCatalog(t)
+
+	entries := c.Entries()
+	if len(entries) != 2 || entries[0].ID != "AUTH-0042" || entries[1].ID != "CONN-0001" {
+		t.Fatalf("Entries() = %v", entries)
+	}
+	entry, ok := c.Get("CONN-0001")
+	if !ok || entry.Def.Code() != 429 || entry.Def.ID() != "CONN-0001" || entry.Def.Error() != "errors.connections.connections_limit" {
+		t.Errorf("Get() = %v, %v", entry, ok)
+	}
+	if _, ok = c.Get("CONN-0002"); ok {
+		t.Error("Get() found unregistered id")
+	}
+
+	tests := []struct {
+		name      string
+		register  func()
+		wantPanic bool
+	}{
+		{"new", func() { c.Register("CONN-0002", 400, "errors.connections", "closed", "") }, false},
+		{"duplicate id", func() { c.Register("CONN-0001", 400, "errors.connections", "other", "") }, true},
+		{"duplicate key", func() { c.Register("CONN-0003", 400, "errors.auth", "expired", "") }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("Register() panic = %v, want panic %v", r, tt.wantPanic)
+				}
+			}()
+			tt.register()
+		})
+	}
+}
+
+func TestCatalog_Lookup(t *testing.T) {
+	c, _ := testCatalog(t)
+	def, _ := c.Get("AUTH-0042")
+
+	tests := []struct {
+		name   string
+		err    error
+		wantID string
+	}{
+		{"error", NewErr("errors.auth", "expired"), "AUTH-0042"},
+		{"wrapped error", fmt.Errorf("login: %w", def.Def.New()), "AUTH-0042"},
+		{"definition", def.Def, "AUTH-0042"},
+		{"unregistered", NewErr("errors.auth", "denied"), ""},
+		{"plain error", errors.New("denied"), ""},
+		{"nil", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, ok := c.Lookup(tt.err)
+			if ok != (tt.wantID != "") || entry.ID != tt.wantID {
+				t.Errorf("Lookup() = %v, %v, want %v", entry.ID, ok, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestCatalog_WriteMarkdown(t *testing.T) {
+	c, translators := testCatalog(t)
+
+	var b strings.Builder
+	if err := c.writeMarkdown(&b, translators); err != nil {
+		t.Fatal(err)
+	}
+	want := "# Errors\n" +
+		"\n## AUTH-0042\n\n`errors.auth.expired`, code 401\n\nSession token | expired\n\n" +
+		"| Locale | Message |\n| --- | --- |\n" +
+		"| en | Session <expired> |\n" +
+		"| cs | Relace vypršela |\n" +
+		"\n## CONN-0001\n\n`errors.connections.connections_limit`, code 429\n\nPlan limit of concurrent connections is reached\n\n" +
+		"| Locale | Message |\n| --- | --- |\n" +
+		"| en | At most {limit} connections |\n" +
+		"| cs |  |\n"
+	if got := b.String(); got != want {
+		t.Errorf("writeMarkdown() = %s, want %s", got, want)
+	}
+}
+
+func TestCatalog_WriteHTML(t *testing.T) {
+	c, translators := testCatalog(t)
+
+	var b strings.Builder
+	if err := c.writeHTML(&b, translators); err != nil {
+		t.Fatal(err)
+	}
+	got := b.String()
+	for _, want := range []string{
+		`<section id="AUTH-0042">`,
+		`<p><code>errors.auth.expired</code>, code 401</p>`,
+		`<tr><td>en</td><td>Session &lt;expired&gt;</td></tr>`,
+		`<tr><td>cs</td><td>Relace vypršela</td></tr>`,
+		`<tr><td>en</td><td>At most {limit} connections</td></tr>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("writeHTML() has no %s:\n%s", want, got)
+		}
+	}
+}
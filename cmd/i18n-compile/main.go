@@ -0,0 +1,44 @@
+// Command i18n-compile Compiles directory of JSON dictionaries to binary format
+// loaded by i18n.InitFromBinary
+//
+//	i18n-compile -dir /usr/lib/app/translations -out translations.bin [-locales en_US,cs_CZ]
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/censync/go-i18n"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory with locale JSON files")
+	out := flag.String("out", "translations.bin", "output file")
+	locales := flag.String("locales", "", "comma separated locales, all files of directory when empty")
+	flag.Parse()
+
+	src := &i18n.DirSource{Path: *dir}
+	if *locales != "" {
+		src.Locales = strings.Split(*locales, ",")
+	}
+	collection, err := src.Load(context.Background())
+	if err != nil {
+		log.Fatalln("Loading dictionaries error", err)
+	}
+
+	file, err := os.Create(*out)
+	if err != nil {
+		log.Fatalln("Creating output error", err)
+	}
+	if err = i18n.WriteBinary(file, collection); err != nil {
+		_ = file.Close()
+		_ = os.Remove(*out)
+		log.Fatalln("Writing binary dictionaries error", err)
+	}
+	if err = file.Close(); err != nil {
+		log.Fatalln("Writing binary dictionaries error", err)
+	}
+}
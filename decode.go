@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// DefaultJSONSection Section of JSON decode error messages, see DefaultDictionaries
+const DefaultJSONSection = "errors.json"
+
+// unknownFieldPrefix Prefix of json.Decoder error with DisallowUnknownFields
+const unknownFieldPrefix = `json: unknown field "`
+
+// FromJSONDecodeError Returns error with code 400 for encoding/json decode error, nil for nil err.
+//
+//	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+//		return i18n.FromJSONDecodeError(err)
+//	}
+//
+// Type errors are added to field path with key of expected type, e.g. "expected_number",
+// values {type} (expected JSON type), {value} (received JSON value) and {offset}.
+// Syntax errors, empty and truncated body are added to default field with keys
+// "syntax", "empty_body" and "unexpected_eof", unknown fields with key "unknown_field".
+// All keys are in DefaultJSONSection, other errors are added by AddDefaultErr.
+func FromJSONDecodeError(err error) *I18nMultipleError {
+	if err == nil {
+		return nil
+	}
+	e := NewMultipleEmptyErr().WithCode(400)
+
+	var (
+		typeErr   *json.UnmarshalTypeError
+		syntaxErr *json.SyntaxError
+	)
+	switch {
+	case errors.As(err, &typeErr):
+		values := M{
+			"{value}":  typeErr.Value,
+			"{offset}": typeErr.Offset,
+		}
+		jsonType := jsonTypeOf(typeErr.Type)
+		if jsonType != "" {
+			values["{type}"] = jsonType
+		}
+		if typeErr.Field == "" {
+			return e.AddDefault(DefaultJSONSection, "invalid_body", values)
+		}
+		key := "invalid_value"
+		if jsonType != "" {
+			key = "expected_" + jsonType
+		}
+		return e.Add(typeErr.Field, DefaultJSONSection, key, values)
+	case errors.As(err, &syntaxErr):
+		return e.AddDefault(DefaultJSONSection, "syntax", M{"{offset}": syntaxErr.Offset})
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return e.AddDefault(DefaultJSONSection, "unexpected_eof")
+	case errors.Is(err, io.EOF):
+		return e.AddDefault(DefaultJSONSection, "empty_body")
+	}
+
+	if msg := err.Error(); strings.HasPrefix(msg, unknownFieldPrefix) {
+		field := strings.TrimSuffix(strings.TrimPrefix(msg, unknownFieldPrefix), `"`)
+		return e.Add(field, DefaultJSONSection, "unknown_field")
+	}
+	return e.AddDefaultErr(err)
+}
+
+// jsonTypeOf Returns JSON type name of Go type: string, number, boolean, array or object,
+// empty string for types without JSON counterpart
+func jsonTypeOf(t reflect.Type) string {
+	if t == nil {
+		return ""
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	default:
+		return ""
+	}
+}
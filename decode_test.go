@@ -0,0 +1,204 @@
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type decodeRequest struct {
+	Name  string `json:"name"`
+	Age   int    `json:"age"`
+	Admin bool   `json:"admin"`
+	Tags  []string
+	Item  struct {
+		Qty int `json:"qty"`
+	} `json:"item"`
+}
+
+func decodeErr(body string, disallowUnknown bool) error {
+	dec := json.NewDecoder(strings.NewReader(body))
+	if disallowUnknown {
+		dec.DisallowUnknownFields()
+	}
+	var req decodeRequest
+	return dec.Decode(&req)
+}
+
+func TestFromJSONDecodeError(t *testing.T) {
+	dicts := DefaultDictionaries()
+	en := testTranslator(t, "en", (*dicts)["en"])
+
+	tests := []struct {
+		name   string
+		err    error
+		fields map[string][]string
+	}{
+		{
+			name:   "expected number",
+			err:    decodeErr(`{"age": "ten"}`, false),
+			fields: map[string][]string{"age": {"age must be a number"}},
+		},
+		{
+			name:   "expected string",
+			err:    decodeErr(`{"name": 5}`, false),
+			fields: map[string][]string{"name": {"name must be a text"}},
+		},
+		{
+			name:   "expected boolean",
+			err:    decodeErr(`{"admin": "yes"}`, false),
+			fields: map[string][]string{"admin": {"admin must be a boolean"}},
+		},
+		{
+			name:   "expected array",
+			err:    decodeErr(`{"Tags": {}}`, false),
+			fields: map[string][]string{"Tags": {"Tags must be a list"}},
+		},
+		{
+			name:   "nested field",
+			err:    decodeErr(`{"item": {"qty": true}}`, false),
+			fields: map[string][]string{"item.qty": {"item.qty must be a number"}},
+		},
+		{
+			name:   "invalid body",
+			err:    decodeErr(`[1, 2]`, false),
+			fields: map[string][]string{"_summary": {"Request body has invalid format"}},
+		},
+		{
+			name:   "syntax",
+			err:    decodeErr(`{"name": }`, false),
+			fields: map[string][]string{"_summary": {"Malformed JSON at position 10"}},
+		},
+		{
+			name:   "unexpected eof",
+			err:    decodeErr(`{"name": "x"`, false),
+			fields: map[string][]string{"_summary": {"Unexpected end of JSON"}},
+		},
+		{
+			name:   "empty body",
+			err:    decodeErr(``, false),
+			fields: map[string][]string{"_summary": {"Request body is empty"}},
+		},
+		{
+			name:   "unknown field",
+			err:    decodeErr(`{"role": "admin"}`, true),
+			fields: map[string][]string{"role": {"Unknown field role"}},
+		},
+		{
+			name:   "other error",
+			err:    errors.New("read: connection reset"),
+			fields: map[string][]string{"_summary": {"Something went wrong"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil {
+				t.Fatal("decode must fail")
+			}
+			got := FromJSONDecodeError(tt.err)
+			if got.Code() != 400 {
+				t.Errorf("FromJSONDecodeError() code = %d, want 400", got.Code())
+			}
+			if fields := got.FieldMessages(en); !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("FromJSONDecodeError() fields = %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+
+	if FromJSONDecodeError(nil) != nil {
+		t.Error("FromJSONDecodeError(nil) must return nil")
+	}
+}
+
+func TestFromJSONDecodeError_Values(t *testing.T) {
+	err := FromJSONDecodeError(decodeErr(`{"age": "ten"}`, false))
+	fieldErr := err.FieldErrors()["age"][0]
+	if fieldErr.section != DefaultJSONSection || fieldErr.key != "expected_number" {
+		t.Fatalf("FromJSONDecodeError() = %s.%s", fieldErr.section, fieldErr.key)
+	}
+	want := map[string]interface{}{"{type}": "number", "{value}": "string", "{offset}": int64(13)}
+	if !reflect.DeepEqual(fieldErr.values, want) {
+		t.Errorf("FromJSONDecodeError() values = %#v, want %#v", fieldErr.values, want)
+	}
+}
+
+func TestDefaultDictionaries(t *testing.T) {
+	dicts := DefaultDictionaries()
+	for _, locale := range []string{"en", "cs", "de", "fr", "es"} {
+		dict, ok := (*dicts)[locale]
+		if !ok {
+			t.Fatalf("DefaultDictionaries() has no %s", locale)
+		}
+		for section, entry := range *(*dicts)["en"] {
+			for key := range *entry {
+				translated, ok := (*dict)[section]
+				if !ok {
+					t.Errorf("DefaultDictionaries() %s has no section %s", locale, section)
+					break
+				}
+				if (*translated)[key] == "" {
+					t.Errorf("DefaultDictionaries() %s has no %s.%s", locale, section, key)
+				}
+			}
+		}
+	}
+
+	// Copies are independent
+	(*(*dicts)["en"])["errors"] = &DictionaryEntry{"unknown": "changed"}
+	if (*(*DefaultDictionaries())["en"])["errors"] == (*(*dicts)["en"])["errors"] {
+		t.Error("DefaultDictionaries() must return copy")
+	}
+}
+
+func TestWithDefaults(t *testing.T) {
+	collection := &DictionaryCollection{
+		"cz": {
+			"errors.json": {"syntax": "Vadný JSON"},
+			"app":         {"title": "Aplikace"},
+		},
+		"ja": {
+			"app": {"title": "アプリ"},
+		},
+	}
+	got := WithDefaults(collection)
+
+	tests := []struct {
+		locale  string
+		section string
+		key     string
+		want    string
+	}{
+		{"cz", "errors.json", "syntax", "Vadný JSON"},
+		{"cz", "errors.json", "empty_body", "Tělo požadavku je prázdné"},
+		{"cz", "app", "title", "Aplikace"},
+		{"ja", "errors.validation", "required", "{field} is required"},
+		{"ja", "app", "title", "アプリ"},
+	}
+	for _, tt := range tests {
+		dict, ok := (*got)[tt.locale]
+		if !ok {
+			t.Fatalf("WithDefaults() has no %s", tt.locale)
+		}
+		if value := (*(*dict)[tt.section])[tt.key]; value != tt.want {
+			t.Errorf("WithDefaults() %s %s.%s = %q, want %q", tt.locale, tt.section, tt.key, value, tt.want)
+		}
+	}
+	if len(*got) != 2 {
+		t.Errorf("WithDefaults() locales = %v, want cz and ja", got.getLocales())
+	}
+	if _, ok := (*(*collection)["cz"])["errors"]; ok {
+		t.Error("WithDefaults() modified collection")
+	}
+}
+
+func TestWithDefaults_Decode(t *testing.T) {
+	err := FromJSONDecodeError(decodeErr(`{"age": 1.5}`, false))
+
+	de := testTranslator(t, "de", (*WithDefaults(&DictionaryCollection{"de_DE": {}}))["de_DE"])
+	if got, want := Translate(de, err), "age: age muss eine Zahl sein"; got != want {
+		t.Errorf("Translate() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,72 @@
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// defaultLanguage Language of built-in messages used for languages without defaults
+const defaultLanguage = "en"
+
+//go:embed defaults/*.json
+var defaultsFS embed.FS
+
+var (
+	defaultsOnce sync.Once
+	defaults     DictionaryCollection
+)
+
+// DefaultDictionaries Returns built-in dictionaries of "en", "cs", "de", "fr" and "es" with
+// messages of DefaultFallbackSection, DefaultJSONSection and DefaultValidationSection.
+// Returned collection is a copy and can be modified
+func DefaultDictionaries() *DictionaryCollection {
+	builtIn := loadDefaults()
+	return builtIn.clone()
+}
+
+// WithDefaults Returns copy of collection with built-in messages added to each locale,
+// locales are matched by language, e.g. "cs_CZ" and "cz" get "cs" messages, locales
+// of other languages get "en" messages. Entries of collection replace built-in ones.
+//
+//	err := i18n.Init("en", i18n.WithDefaults(&dictionaries))
+func WithDefaults(collection *DictionaryCollection) *DictionaryCollection {
+	if collection == nil {
+		return DefaultDictionaries()
+	}
+	builtIn := loadDefaults()
+	result := make(DictionaryCollection, len(*collection))
+	for locale, dict := range *collection {
+		base, ok := builtIn[languageOf(locale)]
+		if !ok {
+			base = builtIn[defaultLanguage]
+		}
+		result[locale] = base.clone()
+		result.merge(&DictionaryCollection{locale: dict})
+	}
+	return &result
+}
+
+// loadDefaults Returns built-in dictionaries decoded once, must not be modified
+func loadDefaults() DictionaryCollection {
+	defaultsOnce.Do(func() {
+		entries, err := defaultsFS.ReadDir("defaults")
+		if err != nil {
+			panic("i18n: read built-in dictionaries: " + err.Error())
+		}
+		defaults = make(DictionaryCollection, len(entries))
+		for _, entry := range entries {
+			data, err := defaultsFS.ReadFile("defaults/" + entry.Name())
+			if err != nil {
+				panic("i18n: read built-in dictionary: " + err.Error())
+			}
+			dict := &Dictionary{}
+			if err = json.Unmarshal(data, dict); err != nil {
+				panic("i18n: decode built-in dictionary " + entry.Name() + ": " + err.Error())
+			}
+			defaults[strings.TrimSuffix(entry.Name(), "."+dictExtension)] = dict
+		}
+	})
+	return defaults
+}
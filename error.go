@@ -1,15 +1,36 @@
 package i18n
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+)
 
 var (
 	nullJSON = []byte("null")
 )
 
+// JSONFormat Wire format of errors
+type JSONFormat uint8
+
+const (
+	// JSONCompact Default format, translated messages when locale is set,
+	// compact {"section": "key"} otherwise
+	JSONCompact JSONFormat = iota
+	// JSONFull Untranslated format with all data needed to restore an error,
+	// {"s": section, "k": key, "v": values, "c": code, "l": locale} for I18nError
+	JSONFull
+	// JSONTranslated Message translated to error locale or default locale with metadata,
+	// {"message": message, "code": code, "id": catalog id} for I18nError,
+	// I18nMultipleError is encoded in JSONCompact form
+	JSONTranslated
+)
+
+// BaseError Untranslated error, section, key and values for formatted output.
+// Full wire form is {"s": section, "k": key, "v": values}, see UnmarshalJSON
 type BaseError struct {
-	section string                 `json:"s,omitempty"`
-	key     string                 `json:"k,omitempty"`
-	values  map[string]interface{} `json:"v,omitempty"`
+	section string
+	key     string
+	values  map[string]interface{}
 }
 
 func (e *BaseError) Section() string {
@@ -24,27 +45,47 @@ func (e *BaseError) Values() map[string]interface{} {
 	return e.values
 }
 
-// I18nError Base error type
+// I18nError Base error type, may wrap an underlying cause
 type I18nError struct {
 	*BaseError
 	code   int
 	locale *string
+	cause  error
+	// id is set for errors of registered definitions, see Catalog
+	id     string
+	format JSONFormat
 }
 
+// MarshalJSON Returns compact {"section": "key"} form
 func (e *BaseError) MarshalJSON() ([]byte, error) {
 	if e == nil {
 		return nullJSON, nil
 	}
 
-	return []byte("{\"" + e.section + "\":\"" + e.key + "\"}"), nil
+	return json.Marshal(map[string]string{e.section: e.key})
 }
 
+// UnmarshalJSON Reads full {"s": section, "k": key, "v": values}
+// or compact {"section": "key"} form
 func (e *BaseError) UnmarshalJSON(b []byte) error {
-	var r struct {
-		Section string                 `json:"s,omitempty"`
-		Key     string                 `json:"k,omitempty"`
-		Values  map[string]interface{} `json:"v,omitempty"`
+	var compact map[string]json.RawMessage
+	if err := json.Unmarshal(b, &compact); err != nil {
+		return err
+	}
+	if len(compact) == 1 {
+		for section, raw := range compact {
+			var key string
+			if !isErrorFullName(section) && json.Unmarshal(raw, &key) == nil {
+				*e = BaseError{
+					section: section,
+					key:     key,
+				}
+				return nil
+			}
+		}
 	}
+
+	var r errorFull
 	err := json.Unmarshal(b, &r)
 	if err != nil {
 		return err
@@ -53,28 +94,179 @@ func (e *BaseError) UnmarshalJSON(b []byte) error {
 	*e = BaseError{
 		section: r.Section,
 		key:     r.Key,
-		values:  r.Values,
+		values:  map[string]interface{}(r.Values),
 	}
 
 	return nil
 }
 
+// errorFull JSONFull form of error, code and locale are set for I18nError only
+type errorFull struct {
+	Section string     `json:"s,omitempty"`
+	Key     string     `json:"k,omitempty"`
+	Values  jsonValues `json:"v,omitempty"`
+	Code    int        `json:"c,omitempty"`
+	Locale  string     `json:"l,omitempty"`
+}
+
+// isErrorFullName Checks name is a field of full form
+func isErrorFullName(name string) bool {
+	return name == "s" || name == "k" || name == "v" || name == "c" || name == "l"
+}
+
+// errorTranslated JSONTranslated form of I18nError
+type errorTranslated struct {
+	Message string `json:"message"`
+	Code    int    `json:"code,omitempty"`
+	ID      string `json:"id,omitempty"`
+}
+
+// isErrorTranslated Checks fields are JSONTranslated form
+func isErrorTranslated(fields map[string]json.RawMessage) bool {
+	if _, ok := fields["message"]; !ok {
+		return false
+	}
+	for name := range fields {
+		if name != "message" && name != "code" && name != "id" {
+			return false
+		}
+	}
+	return true
+}
+
+// marshalFull Returns full {"s": section, "k": key, "v": values} form
+func (e *BaseError) marshalFull() ([]byte, error) {
+	return json.Marshal(errorFull{
+		Section: e.section,
+		Key:     e.key,
+		Values:  jsonValues(e.values),
+	})
+}
+
+// MarshalJSON Returns error in format set by WithFormat, JSONCompact form is translated
+// message when locale is set or message is restored, {"section": "key"} otherwise, see JSONFormat.
+// Before Init errors are not translated, JSONTranslated message is "section.key"
 func (e *I18nError) MarshalJSON() ([]byte, error) {
-	if e == nil {
+	if e == nil || e.BaseError == nil {
 		return nullJSON, nil
 	}
 
-	if e.locale != nil && *e.locale != "" {
-		trStr := ""
-		if len(e.values) == 0 {
-			trStr = Get(*e.locale).T(e.section, e.key)
-		} else {
-			trStr = Get(*e.locale).Tf(e.section, e.key, e.values)
+	locale := ""
+	if e.locale != nil {
+		locale = *e.locale
+	}
+	switch e.format {
+	case JSONFull:
+		return json.Marshal(errorFull{
+			Section: e.section,
+			Key:     e.key,
+			Values:  jsonValues(e.values),
+			Code:    e.code,
+			Locale:  locale,
+		})
+	case JSONTranslated:
+		message, ok := e.translated(locale)
+		if !ok {
+			message = e.Error()
+		}
+		return json.Marshal(errorTranslated{
+			Message: message,
+			Code:    e.code,
+			ID:      e.catalogID(),
+		})
+	default:
+		if e.section == messageErrSection || locale != "" {
+			if message, ok := e.translated(locale); ok {
+				return json.Marshal(message)
+			}
+		}
+		return e.BaseError.MarshalJSON()
+	}
+}
+
+// translated Returns message translated to locale or default locale, restored message as is,
+// false when translator is not initialized
+func (e *I18nError) translated(locale string) (string, bool) {
+	if e.section == messageErrSection {
+		return e.key, true
+	}
+	tr := getTranslator(locale)
+	if tr == nil {
+		return "", false
+	}
+	return e.BaseError.translate(tr, ""), true
+}
+
+// UnmarshalJSON Reads error in any format, translated messages are restored
+// with section "_message" and message as a key. JSON null keeps error unchanged
+func (e *I18nError) UnmarshalJSON(b []byte) error {
+	if string(b) == string(nullJSON) {
+		return nil
+	}
+	var message string
+	if json.Unmarshal(b, &message) == nil {
+		*e = I18nError{
+			BaseError: &BaseError{
+				section: messageErrSection,
+				key:     message,
+			},
 		}
-		return []byte(`"` + trStr + `"`), nil
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+	if isErrorTranslated(fields) {
+		var r errorTranslated
+		if err := json.Unmarshal(b, &r); err != nil {
+			return err
+		}
+		*e = I18nError{
+			BaseError: &BaseError{
+				section: messageErrSection,
+				key:     r.Message,
+			},
+			code:   r.Code,
+			id:     r.ID,
+			format: JSONTranslated,
+		}
+		return nil
+	}
+
+	base := &BaseError{}
+	if err := base.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	*e = I18nError{
+		BaseError: base,
 	}
+	if _, ok := fields[base.section]; ok && len(fields) == 1 {
+		return nil
+	}
+	// Full form
+	var r errorFull
+	if err := json.Unmarshal(b, &r); err != nil {
+		return err
+	}
+	e.code = r.Code
+	if r.Locale != "" {
+		e.locale = &r.Locale
+	}
+	e.format = JSONFull
+	return nil
+}
 
-	return e.BaseError.MarshalJSON()
+// catalogID Returns id of error definition, or id registered in DefaultCatalog
+func (e *I18nError) catalogID() string {
+	if e.id != "" {
+		return e.id
+	}
+	if entry, ok := DefaultCatalog.lookup(e.section, e.key); ok {
+		return entry.ID
+	}
+	return ""
 }
 
 // Error Returns concatenated string "section.key"
@@ -130,7 +322,14 @@ func NewErrWithCode(code int, section string, key string, values ...M) *I18nErro
 
 }
 
-// Errors setters
+// WrapErr Creates *I18nError object wrapping cause
+func WrapErr(cause error, section string, key string, values ...M) *I18nError {
+	e := NewErr(section, key, values...)
+	e.cause = cause
+	return e
+}
+
+// Errors setters, modify receiver, use builders for shared errors
 
 // SetCode Set status code, e.g. `err.SetCode(http.StatusBadRequest)`
 func (e *I18nError) SetCode(code int) {
@@ -142,6 +341,11 @@ func (e *I18nError) SetLocale(locale string) {
 	e.locale = &locale
 }
 
+// SetFormat Set JSON wire format
+func (e *I18nError) SetFormat(format JSONFormat) {
+	e.format = format
+}
+
 // SetSection Set translatorsCollection section
 func (e *I18nError) SetSection(section string) {
 	e.section = section
@@ -157,36 +361,60 @@ func (e *I18nError) SetValues(values M) {
 	e.values = values
 }
 
-// Errors builders
+// Errors builders, return modified copy, so receiver can be shared between goroutines
 
-// WithCode Returns error with status code
+// WithCode Returns error copy with status code
 func (e *I18nError) WithCode(code int) *I18nError {
-	e.code = code
-	return e
+	c := e.clone()
+	c.code = code
+	return c
 }
 
-// WithLocale Returns error with locale
+// WithLocale Returns error copy with locale
 func (e *I18nError) WithLocale(locale string) *I18nError {
-	e.locale = &locale
-	return e
+	c := e.clone()
+	c.locale = &locale
+	return c
 }
 
-// WithSection Returns error with translatorsCollection section
+// WithFormat Returns error copy with JSON wire format
+func (e *I18nError) WithFormat(format JSONFormat) *I18nError {
+	c := e.clone()
+	c.format = format
+	return c
+}
+
+// WithSection Returns error copy with translatorsCollection section
 func (e *I18nError) WithSection(section string) *I18nError {
-	e.section = section
-	return e
+	c := e.clone()
+	c.section = section
+	return c
 }
 
-// WithKey Returns error with translatorsCollection key
+// WithKey Returns error copy with translatorsCollection key
 func (e *I18nError) WithKey(key string) *I18nError {
-	e.key = key
-	return e
+	c := e.clone()
+	c.key = key
+	return c
 }
 
-// WithValues Returns error with values for formatted output
+// WithValues Returns error copy with values for formatted output
 func (e *I18nError) WithValues(values M) *I18nError {
-	e.values = values
-	return e
+	c := e.clone()
+	c.values = values
+	return c
+}
+
+// clone Returns copy of error not sharing BaseError with receiver
+func (e *I18nError) clone() *I18nError {
+	c := *e
+	if e.BaseError != nil {
+		base := *e.BaseError
+		c.BaseError = &base
+	} else {
+		c.BaseError = &BaseError{}
+	}
+	return &c
 }
 
 // Errors getters
@@ -196,6 +424,21 @@ func (e *I18nError) Code() int {
 	return e.code
 }
 
+// Locale Returns priority locale, nil when not set
+func (e *I18nError) Locale() *string {
+	return e.locale
+}
+
+// ID Returns stable identifier of error created by registered definition, see Catalog
+func (e *I18nError) ID() string {
+	return e.id
+}
+
+// Format Returns JSON wire format
+func (e *I18nError) Format() JSONFormat {
+	return e.format
+}
+
 // Section Returns translatorsCollection section
 func (e *I18nError) Section() string {
 	return e.section
@@ -207,28 +450,58 @@ func (e *I18nError) Key() string {
 }
 
 // Values Returns values for formatted output
-func (e *I18nError) Values() string {
-	return e.key
+func (e *I18nError) Values() map[string]interface{} {
+	return e.values
+}
+
+// Errors wrapping
+
+// Wrap Returns copy of error wrapping cause, e.g. `return ErrNotFound.Wrap(sql.ErrNoRows)`
+func (e *I18nError) Wrap(cause error) *I18nError {
+	wrapped := e.clone()
+	wrapped.cause = cause
+	return wrapped
+}
+
+// Unwrap Returns wrapped cause
+func (e *I18nError) Unwrap() error {
+	return e.cause
+}
+
+// Is Reports whether target is *I18nError or ErrorDef with same section and key, values are ignored,
+// so package level errors can be used as sentinels with errors.Is
+func (e *I18nError) Is(target error) bool {
+	if e.BaseError == nil {
+		return false
+	}
+	switch t := target.(type) {
+	case *I18nError:
+		return t != nil && t.BaseError != nil && e.section == t.section && e.key == t.key
+	case ErrorDef:
+		return e.section == t.section && e.key == t.key
+	default:
+		return false
+	}
 }
 
 // Errors translator functions
 
-// T Returns translated string from I18nError
+// T Returns translated string from I18nError, restored translated message is returned as is
 func (e *I18nError) T(tr *Translator) string {
-	return tr.T(e.section, e.key)
+	return e.translateT(tr)
 }
 
-// Tf Returns translated formatted string from I18nError
+// Tf Returns translated formatted string from I18nError, restored translated message is returned as is
 func (e *I18nError) Tf(tr *Translator) string {
-	return tr.Tf(e.section, e.key, e.values)
+	return e.translate(tr, "")
 }
 
 // ErrT Returns translated error from I18nError
 func (e *I18nError) ErrT(tr *Translator) error {
-	return tr.ErrT(e.section, e.key)
+	return errors.New(e.T(tr))
 }
 
 // ErrTf Returns translated formatted error from I18nError
 func (e *I18nError) ErrTf(tr *Translator) error {
-	return tr.ErrTf(e.section, e.key, e.values)
+	return errors.New(e.Tf(tr))
 }
@@ -0,0 +1,72 @@
+package i18n
+
+// ErrorDef Immutable error definition, creates fresh *I18nError for each use,
+// so it is safe for package level variables shared between goroutines,
+// e.g. `var ErrLimit = i18n.DefineWithCode(http.StatusTooManyRequests, "errors", "limit")`
+type ErrorDef struct {
+	// id is set for definitions of Catalog
+	id      string
+	code    int
+	section string
+	key     string
+}
+
+// Define Creates error definition
+func Define(section string, key string) ErrorDef {
+	return ErrorDef{
+		section: section,
+		key:     key,
+	}
+}
+
+// DefineWithCode Creates error definition with status code
+func DefineWithCode(code int, section string, key string) ErrorDef {
+	return ErrorDef{
+		code:    code,
+		section: section,
+		key:     key,
+	}
+}
+
+// New Returns new *I18nError of definition
+func (d ErrorDef) New(values ...M) *I18nError {
+	e := NewErrWithCode(d.code, d.section, d.key, values...)
+	e.id = d.id
+	return e
+}
+
+// Wrap Returns new *I18nError of definition wrapping cause
+func (d ErrorDef) Wrap(cause error, values ...M) *I18nError {
+	e := d.New(values...)
+	e.cause = cause
+	return e
+}
+
+// Error Returns concatenated string "section.key", definition can be used as errors.Is target
+func (d ErrorDef) Error() string {
+	if d.key != "" {
+		return d.section + "." + d.key
+	} else {
+		return d.section
+	}
+}
+
+// ID Returns stable identifier of registered error, empty when not registered in Catalog
+func (d ErrorDef) ID() string {
+	return d.id
+}
+
+// Code Returns status code
+func (d ErrorDef) Code() int {
+	return d.code
+}
+
+// Section Returns translatorsCollection section
+func (d ErrorDef) Section() string {
+	return d.section
+}
+
+// Key Returns translatorsCollection key
+func (d ErrorDef) Key() string {
+	return d.key
+}
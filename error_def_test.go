@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+var errLimit = DefineWithCode(429, "errors.connections", "connections_limit")
+
+func TestErrorDef_New(t *testing.T) {
+	dict := &Dictionary{
+		"errors.connections": {
+			"connections_limit": "Connections limit is {count}",
+		},
+	}
+	tr := testTranslator(t, "en", dict)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			e := errLimit.New(M{"{count}": i}).WithLocale("en")
+			if got, want := e.Tf(tr), fmt.Sprintf("Connections limit is %d", i); got != want {
+				t.Errorf("New().Tf() = %v, want %v", got, want)
+			}
+			if e.Code() != 429 {
+				t.Errorf("New().Code() = %v, want 429", e.Code())
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	cause := errors.New("too many connections")
+	wrapped := fmt.Errorf("accept: %w", errLimit.Wrap(cause))
+	if !errors.Is(wrapped, errLimit) || !errors.Is(wrapped, cause) {
+		t.Errorf("errors.Is() = false for %v", wrapped)
+	}
+	if errors.Is(NewErr("errors.connections", "other"), errLimit) {
+		t.Error("errors.Is() = true for other key")
+	}
+	if errLimit.Error() != "errors.connections.connections_limit" {
+		t.Errorf("Error() = %v", errLimit.Error())
+	}
+}
+
+func TestI18nError_Builders(t *testing.T) {
+	shared := NewErr("errors", "limit", M{"{count}": 1})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			e := shared.WithValues(M{"{count}": i}).WithCode(400).WithLocale("en").WithSection("form").WithKey("max")
+			if e.Values()["{count}"] != i || e.Code() != 400 || e.Error() != "form.max" {
+				t.Errorf("builders = %v %v %v", e.Values(), e.Code(), e)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if shared.Values()["{count}"] != 1 || shared.Code() != 0 || shared.Locale() != nil || shared.Error() != "errors.limit" {
+		t.Errorf("builders changed receiver: %v %v %v %v", shared.Values(), shared.Code(), shared.Locale(), shared)
+	}
+}
@@ -1,136 +1,343 @@
 package i18n
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
 
 var (
 	multipleDefaultErrorField = "_summary"
 )
 
+const (
+	// rawErrSection Section of errors added by AddDefaultErr, key is message of untranslatable error
+	rawErrSection = "_error"
+	// messageErrSection Section of restored translated messages, key is message
+	messageErrSection = "_message"
+)
+
+// I18nMultipleError Errors of several fields, e.g. form validation errors.
+// Each field holds a list of errors in order of adding, fields of nested
+// payloads are paths like "address.street" and "items[2].qty", see FieldPath and Nest.
+//
+// JSON wire format depends on format and locale, field with one error is encoded
+// as a single value, field with several errors as an array:
+//
+//	JSONCompact with locale:    {"field": "translated message", "other": ["message", "message"]}
+//	JSONCompact without locale: {"field": {"section": "key"}}
+//	JSONFull:                   {"c": code, "l": locale, "e": {"field": {"s": section, "k": key, "v": values}}}
+//
+// Empty error is encoded as null. UnmarshalJSON reads all forms,
+// translated messages are restored with section "_message" and message as a key.
 type I18nMultipleError struct {
-	code   int                   `json:"c,omitempty"`
-	locale *string               `json:"l,omitempty"`
-	errors map[string]*BaseError `json:"e,omitempty"`
+	code   int
+	locale *string
+	format JSONFormat
+	labels string
+	errors map[string][]*BaseError
+}
+
+// multipleErrorFull JSONFull form of I18nMultipleError
+type multipleErrorFull struct {
+	Code   int                        `json:"c,omitempty"`
+	Locale string                     `json:"l,omitempty"`
+	Errors map[string]json.RawMessage `json:"e"`
 }
 
 func NewMultipleEmptyErr() *I18nMultipleError {
 	return &I18nMultipleError{
-		errors: map[string]*BaseError{},
+		errors: map[string][]*BaseError{},
 	}
 }
 
 func NewMultipleErr(field, section string, key string, values ...M) *I18nMultipleError {
+	return &I18nMultipleError{
+		errors: map[string][]*BaseError{
+			field: {newBaseError(section, key, values)},
+		},
+	}
+}
+
+func NewMultipleDefaultErr(section string, key string, values ...M) *I18nMultipleError {
+	return &I18nMultipleError{
+		errors: map[string][]*BaseError{
+			multipleDefaultErrorField: {newBaseError(section, key, values)},
+		},
+	}
+}
+
+// newBaseError Creates *BaseError with first of values
+func newBaseError(section string, key string, values []M) *BaseError {
 	if len(values) == 0 {
-		return &I18nMultipleError{
-			errors: map[string]*BaseError{
-				field: {
-					section: section,
-					key:     key,
-				},
-			},
+		return &BaseError{
+			section: section,
+			key:     key,
+		}
+	}
+	return &BaseError{
+		section: section,
+		key:     key,
+		values:  values[0],
+	}
+}
+
+// MarshalJSON Returns error in wire format, see I18nMultipleError
+func (e *I18nMultipleError) MarshalJSON() ([]byte, error) {
+	if e == nil || len(e.errors) == 0 {
+		return nullJSON, nil
+	}
+
+	if e.format == JSONFull {
+		return e.marshalFull()
+	}
+
+	// Fields are not translated, when translator is not initialized
+	var tr *Translator
+	if e.locale != nil && *e.locale != "" {
+		tr = getTranslator(*e.locale)
+	}
+	fields := make(map[string]json.RawMessage, len(e.errors))
+	for field, fieldErrs := range e.errors {
+		marshal := (*BaseError).MarshalJSON
+		if tr != nil {
+			label := e.label(tr, field)
+			marshal = func(fieldErr *BaseError) ([]byte, error) {
+				return json.Marshal(fieldErr.translate(tr, label))
+			}
 		}
-	} else {
-		return &I18nMultipleError{
-			errors: map[string]*BaseError{
-				field: {
-					section: section,
-					key:     key,
-					values:  values[0],
-				},
-			},
+		b, err := marshalField(fieldErrs, marshal)
+		if err != nil {
+			return nil, err
 		}
+		fields[field] = b
 	}
+	return json.Marshal(fields)
 }
 
-func NewMultipleDefaultErr(section string, key string, values ...M) *I18nMultipleError {
-	if len(values) == 0 {
-		return &I18nMultipleError{
-			errors: map[string]*BaseError{
-				multipleDefaultErrorField: {
-					section: section,
-					key:     key,
-				},
-			},
+// marshalFull Returns JSONFull form
+func (e *I18nMultipleError) marshalFull() ([]byte, error) {
+	r := multipleErrorFull{
+		Code:   e.code,
+		Errors: make(map[string]json.RawMessage, len(e.errors)),
+	}
+	if e.locale != nil {
+		r.Locale = *e.locale
+	}
+	for field, fieldErrs := range e.errors {
+		b, err := marshalField(fieldErrs, (*BaseError).marshalFull)
+		if err != nil {
+			return nil, err
 		}
-	} else {
-		return &I18nMultipleError{
-			errors: map[string]*BaseError{
-				multipleDefaultErrorField: {
-					section: section,
-					key:     key,
-					values:  values[0],
-				},
-			},
+		r.Errors[field] = b
+	}
+	return json.Marshal(r)
+}
+
+// marshalField Returns single value for one error, array for several errors
+func marshalField(fieldErrs []*BaseError, marshal func(*BaseError) ([]byte, error)) (json.RawMessage, error) {
+	if len(fieldErrs) == 1 {
+		return marshal(fieldErrs[0])
+	}
+	list := make([]json.RawMessage, len(fieldErrs))
+	for i, fieldErr := range fieldErrs {
+		b, err := marshal(fieldErr)
+		if err != nil {
+			return nil, err
 		}
+		list[i] = b
 	}
+	return json.Marshal(list)
 }
 
+// UnmarshalJSON Reads error in any wire format, see I18nMultipleError
 func (e *I18nMultipleError) UnmarshalJSON(b []byte) error {
-	var r struct {
-		Section string                 `json:"s,omitempty"`
-		Key     string                 `json:"k,omitempty"`
-		Values  map[string]interface{} `json:"v,omitempty"`
-	}
-	err := json.Unmarshal(b, &r)
-	if err != nil {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
 		return err
 	}
 
+	*e = I18nMultipleError{
+		errors: map[string][]*BaseError{},
+	}
+	if isMultipleErrorFull(fields) {
+		var r multipleErrorFull
+		if err := json.Unmarshal(b, &r); err != nil {
+			return err
+		}
+		e.code = r.Code
+		if r.Locale != "" {
+			e.locale = &r.Locale
+		}
+		e.format = JSONFull
+		fields = r.Errors
+	}
+
+	for field, raw := range fields {
+		var list []json.RawMessage
+		if json.Unmarshal(raw, &list) != nil {
+			list = []json.RawMessage{raw}
+		}
+		for _, rawErr := range list {
+			fieldErr, err := unmarshalFieldErr(rawErr)
+			if err != nil {
+				return err
+			}
+			e.errors[field] = append(e.errors[field], fieldErr)
+		}
+	}
 	return nil
 }
 
-func (e *I18nMultipleError) Add(field, section string, key string, values ...M) *I18nMultipleError {
-	if e.code == 0 {
-		e.code = 400
+// unmarshalFieldErr Reads translated message or error in full or compact form
+func unmarshalFieldErr(raw json.RawMessage) (*BaseError, error) {
+	var message string
+	if json.Unmarshal(raw, &message) == nil {
+		return &BaseError{
+			section: messageErrSection,
+			key:     message,
+		}, nil
 	}
+	fieldErr := &BaseError{}
+	if err := fieldErr.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	return fieldErr, nil
+}
 
-	if len(values) == 0 {
-		e.errors[field] = &BaseError{
-			section: section,
-			key:     key,
+// isMultipleErrorFull Checks fields are JSONFull form
+func isMultipleErrorFull(fields map[string]json.RawMessage) bool {
+	rawErrors, ok := fields["e"]
+	if !ok {
+		return false
+	}
+	for name := range fields {
+		if name != "c" && name != "l" && name != "e" {
+			return false
 		}
-	} else {
-		e.errors[field] = &BaseError{
-			section: section,
-			key:     key,
-			values:  values[0],
+	}
+	var errs map[string]json.RawMessage
+	if err := json.Unmarshal(rawErrors, &errs); err != nil {
+		return false
+	}
+	for _, raw := range errs {
+		var list []map[string]json.RawMessage
+		if json.Unmarshal(raw, &list) != nil {
+			var fieldErr map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &fieldErr); err != nil {
+				return false
+			}
+			list = []map[string]json.RawMessage{fieldErr}
+		}
+		for _, fieldErr := range list {
+			for name := range fieldErr {
+				if name != "s" && name != "k" && name != "v" {
+					return false
+				}
+			}
 		}
 	}
+	return true
+}
+
+// Add Appends error of field, field may be a path, see FieldPath
+func (e *I18nMultipleError) Add(field, section string, key string, values ...M) *I18nMultipleError {
+	if e.code == 0 {
+		e.code = 400
+	}
+	e.add(field, newBaseError(section, key, values))
 	return e
 }
 
+// AddDefault Appends error not related to a field
 func (e *I18nMultipleError) AddDefault(section string, key string, values ...M) *I18nMultipleError {
 	if e.code == 0 {
 		e.code = 500
 	}
+	e.add(multipleDefaultErrorField, newBaseError(section, key, values))
+	return e
+}
 
-	if len(values) == 0 {
-		e.errors[multipleDefaultErrorField] = &BaseError{
-			section: section,
-			key:     key,
-		}
-	} else {
-		e.errors[multipleDefaultErrorField] = &BaseError{
-			section: section,
-			key:     key,
-			values:  values[0],
+// AddDefaultErr Replaces errors with srcErr when it is *I18nMultipleError, otherwise appends srcErr
+// to default field, i18n error is kept translatable, message of other errors is replaced by fallback on translation
+func (e *I18nMultipleError) AddDefaultErr(srcErr error) *I18nMultipleError {
+	var (
+		mErr    *I18nMultipleError
+		i18nErr *I18nError
+	)
+	switch {
+	case errors.As(srcErr, &mErr):
+		*e = *mErr.clone()
+	case errors.As(srcErr, &i18nErr) && i18nErr.BaseError != nil:
+		e.add(multipleDefaultErrorField, i18nErr.BaseError)
+		if e.code == 0 {
+			e.code = i18nErr.code
 		}
+	default:
+		e.add(multipleDefaultErrorField, &BaseError{
+			section: rawErrSection,
+			key:     srcErr.Error(),
+		})
 	}
 	return e
 }
 
-func (e *I18nMultipleError) AddDefaultErr(srcErr error) *I18nMultipleError {
-	mErr, ok := srcErr.(*I18nMultipleError)
-	if !ok {
-		*e = *mErr
-	} else {
-		e.errors[multipleDefaultErrorField] = &BaseError{
-			section: "_error",
-			key:     srcErr.Error(),
-		}
+// clone Returns copy of error not sharing field lists with receiver
+func (e *I18nMultipleError) clone() *I18nMultipleError {
+	c := *e
+	c.errors = make(map[string][]*BaseError, len(e.errors))
+	for field, fieldErrs := range e.errors {
+		c.errors[field] = append([]*BaseError(nil), fieldErrs...)
+	}
+	return &c
+}
+
+func (e *I18nMultipleError) add(field string, fieldErrs ...*BaseError) {
+	if e.errors == nil {
+		e.errors = map[string][]*BaseError{}
+	}
+	e.errors[field] = append(e.errors[field], fieldErrs...)
+}
+
+// Nest Appends errors of other with fields prefixed by prefix path, e.g. errors of
+// nested struct validation, default field of other becomes prefix field.
+// Code is taken from other when not set.
+func (e *I18nMultipleError) Nest(prefix string, other *I18nMultipleError) *I18nMultipleError {
+	if other == nil {
+		return e
+	}
+	if e.code == 0 {
+		e.code = other.code
+	}
+	for _, field := range other.fields() {
+		e.add(joinField(prefix, field), other.errors[field]...)
 	}
 	return e
 }
 
+// WithCode Returns copy of error with status code
+func (e *I18nMultipleError) WithCode(code int) *I18nMultipleError {
+	c := e.clone()
+	c.code = code
+	return c
+}
+
+// WithLocale Returns copy of error with locale, fields are translated in JSON
+func (e *I18nMultipleError) WithLocale(locale string) *I18nMultipleError {
+	c := e.clone()
+	c.locale = &locale
+	return c
+}
+
+// WithFormat Returns copy of error with JSON wire format
+func (e *I18nMultipleError) WithFormat(format JSONFormat) *I18nMultipleError {
+	c := e.clone()
+	c.format = format
+	return c
+}
+
 func (e *I18nMultipleError) HasErrors() bool {
 	return len(e.errors) > 0
 }
@@ -151,6 +358,79 @@ func (e *I18nMultipleError) Error() string {
 	return string(b)
 }
 
+// Errors Returns first error of each field
 func (e *I18nMultipleError) Errors() map[string]*BaseError {
+	errs := make(map[string]*BaseError, len(e.errors))
+	for field, fieldErrs := range e.errors {
+		if len(fieldErrs) > 0 {
+			errs[field] = fieldErrs[0]
+		}
+	}
+	return errs
+}
+
+// FieldErrors Returns all errors of each field in order of adding
+func (e *I18nMultipleError) FieldErrors() map[string][]*BaseError {
 	return e.errors
 }
+
+// Fields Returns sorted fields with errors
+func (e *I18nMultipleError) Fields() []string {
+	return e.fields()
+}
+
+func (e *I18nMultipleError) fields() []string {
+	fields := make([]string, 0, len(e.errors))
+	for field := range e.errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// Unwrap Returns field errors as *I18nError sorted by field, errors.Is and errors.As
+// match any of them
+func (e *I18nMultipleError) Unwrap() []error {
+	errs := make([]error, 0, len(e.errors))
+	for _, field := range e.fields() {
+		for _, fieldErr := range e.errors[field] {
+			errs = append(errs, &I18nError{
+				BaseError: fieldErr,
+				code:      e.code,
+				locale:    e.locale,
+			})
+		}
+	}
+	return errs
+}
+
+// FieldPath Returns field path of parts, strings are joined with dots, integers are indexes,
+// e.g. FieldPath("items", 2, "qty") is "items[2].qty"
+func FieldPath(parts ...interface{}) string {
+	var path []byte
+	for _, part := range parts {
+		switch p := part.(type) {
+		case int:
+			path = append(strconv.AppendInt(append(path, '['), int64(p), 10), ']')
+		case string:
+			path = []byte(joinField(string(path), p))
+		default:
+			path = []byte(joinField(string(path), fmt.Sprint(p)))
+		}
+	}
+	return string(path)
+}
+
+// joinField Returns field path of prefix and field, default field is prefix itself
+func joinField(prefix string, field string) string {
+	switch {
+	case prefix == "":
+		return field
+	case field == "" || field == multipleDefaultErrorField:
+		return prefix
+	case field[0] == '[':
+		return prefix + field
+	default:
+		return prefix + "." + field
+	}
+}
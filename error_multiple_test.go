@@ -2,6 +2,8 @@ package i18n
 
 import (
 	"encoding/json"
+	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -23,11 +25,11 @@ func TestI18nMultipleError_EmptyLocale_MarshalJSON(t *testing.T) {
 			name: "filled i18n multiple error",
 			setErr: func() *I18nMultipleError {
 				return &I18nMultipleError{
-					errors: map[string]*BaseError{
-						"field1": {
+					errors: map[string][]*BaseError{
+						"field1": {{
 							section: "user_section1",
 							key:     "error_key1",
-						},
+						}},
 					},
 				}
 			},
@@ -37,11 +39,11 @@ func TestI18nMultipleError_EmptyLocale_MarshalJSON(t *testing.T) {
 			name: "partial fill i18n error",
 			setErr: func() *I18nMultipleError {
 				return &I18nMultipleError{
-					errors: map[string]*BaseError{
-						"field1": {
+					errors: map[string][]*BaseError{
+						"field1": {{
 							section: "user_section",
 							key:     "error_key",
-						},
+						}},
 					},
 				}
 			},
@@ -93,11 +95,11 @@ func TestNestedI18nMultipleError_EmptyLocale_MarshalJSON(t *testing.T) {
 				return &TestStructMultiple{
 					Data: "test_data",
 					Errors: &I18nMultipleError{
-						errors: map[string]*BaseError{
-							"field1": {
+						errors: map[string][]*BaseError{
+							"field1": {{
 								section: "user_section1",
 								key:     "error_key1",
-							},
+							}},
 						},
 					},
 				}
@@ -121,3 +123,276 @@ func TestNestedI18nMultipleError_EmptyLocale_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestI18nMultipleError_JSONRoundTrip(t *testing.T) {
+	err := initDict()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		setErr   func() *I18nMultipleError
+		wantJSON string
+		want     *I18nMultipleError
+	}{
+		{
+			name: "full format",
+			setErr: func() *I18nMultipleError {
+				return NewMultipleErr("field1", "fields.errors", "to_short", M{"{min}": 3}).
+					WithCode(422).
+					WithLocale("cz").
+					WithFormat(JSONFull)
+			},
+			wantJSON: `{"c":422,"l":"cz","e":{"field1":{"s":"fields.errors","k":"to_short","v":{"{min}":3}}}}`,
+			want: func() *I18nMultipleError {
+				locale := "cz"
+				return &I18nMultipleError{
+					code:   422,
+					locale: &locale,
+					format: JSONFull,
+					errors: map[string][]*BaseError{
+						"field1": {{section: "fields.errors", key: "to_short", values: map[string]interface{}{"{min}": float64(3)}}},
+					},
+				}
+			}(),
+		},
+		{
+			name: "compact format",
+			setErr: func() *I18nMultipleError {
+				return NewMultipleErr("e", "fields.errors", "to_long")
+			},
+			wantJSON: `{"e":{"fields.errors":"to_long"}}`,
+			want: &I18nMultipleError{
+				errors: map[string][]*BaseError{
+					"e": {{section: "fields.errors", key: "to_long"}},
+				},
+			},
+		},
+		{
+			name: "translated format",
+			setErr: func() *I18nMultipleError {
+				return NewMultipleErr("field1", "fields.errors", "to_long").WithLocale("cz")
+			},
+			wantJSON: `{"field1":"Pole je příliš dlouhé"}`,
+			want: &I18nMultipleError{
+				errors: map[string][]*BaseError{
+					"field1": {{section: "_message", key: "Pole je příliš dlouhé"}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.setErr())
+			if err != nil {
+				t.Fatalf("I18nMultipleError.MarshalJSON() error = %v", err)
+			}
+			if string(b) != tt.wantJSON {
+				t.Errorf("I18nMultipleError.MarshalJSON() = %s, want %s", b, tt.wantJSON)
+			}
+
+			got := &I18nMultipleError{}
+			if err = json.Unmarshal(b, got); err != nil {
+				t.Fatalf("I18nMultipleError.UnmarshalJSON() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("I18nMultipleError.UnmarshalJSON() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestI18nMultipleError_UnmarshalJSON_Invalid(t *testing.T) {
+	for _, data := range []string{`[]`, `{"field1":1}`, `{"field1":`} {
+		if err := json.Unmarshal([]byte(data), &I18nMultipleError{}); err == nil {
+			t.Errorf("I18nMultipleError.UnmarshalJSON(%s) error = nil, want error", data)
+		}
+	}
+}
+
+func TestI18nMultipleError_AddDefaultErr(t *testing.T) {
+	tests := []struct {
+		name   string
+		srcErr error
+		want   *I18nMultipleError
+	}{
+		{
+			name:   "multiple error",
+			srcErr: NewMultipleErr("email", "errors", "required"),
+			want: &I18nMultipleError{errors: map[string][]*BaseError{
+				"email": {{section: "errors", key: "required"}},
+			}},
+		},
+		{
+			name:   "i18n error",
+			srcErr: NewErrWithCode(409, "errors", "exists"),
+			want: &I18nMultipleError{code: 409, errors: map[string][]*BaseError{
+				"_summary": {{section: "errors", key: "exists"}},
+			}},
+		},
+		{
+			name:   "plain error",
+			srcErr: errors.New("timeout"),
+			want: &I18nMultipleError{errors: map[string][]*BaseError{
+				"_summary": {{section: "_error", key: "timeout"}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (&I18nMultipleError{}).AddDefaultErr(tt.srcErr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AddDefaultErr() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestI18nMultipleError_AddDefaultErr_Copy(t *testing.T) {
+	src := NewMultipleErr("email", "errors", "required").Add("name", "errors", "required")
+
+	e := NewMultipleEmptyErr().AddDefaultErr(src)
+	e.Add("email", "errors", "invalid").Add("age", "errors", "min")
+
+	want := map[string][]*BaseError{
+		"email": {{section: "errors", key: "required"}},
+		"name":  {{section: "errors", key: "required"}},
+	}
+	if !reflect.DeepEqual(src.FieldErrors(), want) {
+		t.Errorf("AddDefaultErr() source = %v, want %v", src.FieldErrors(), want)
+	}
+	if got := e.FieldErrors(); len(got) != 3 || len(got["email"]) != 2 {
+		t.Errorf("AddDefaultErr() = %v", got)
+	}
+}
+
+func TestI18nMultipleError_FieldErrors(t *testing.T) {
+	address := NewMultipleErr("street", "errors", "required").
+		Add("zip", "errors", "invalid").
+		Add("zip", "errors", "too_long")
+	item := NewMultipleDefaultErr("errors", "out_of_stock").
+		Add("qty", "errors", "min", M{"{min}": 1})
+
+	e := NewMultipleErr("name", "errors", "required").
+		Add("name", "errors", "too_short").
+		Nest("address", address).
+		Nest(FieldPath("items", 2), item)
+
+	wantFields := []string{"address.street", "address.zip", "items[2]", "items[2].qty", "name"}
+	if got := e.Fields(); !reflect.DeepEqual(got, wantFields) {
+		t.Errorf("Fields() = %v, want %v", got, wantFields)
+	}
+	if got := e.FieldErrors()["address.zip"]; len(got) != 2 || got[1].Key() != "too_long" {
+		t.Errorf("FieldErrors() address.zip = %v", got)
+	}
+	if got := e.Errors()["name"]; got.Key() != "required" {
+		t.Errorf("Errors() name = %v, want first error", got)
+	}
+	if e.Code() != 400 {
+		t.Errorf("Code() = %v, want 400", e.Code())
+	}
+
+	wantJSON := `{"address.street":{"errors":"required"},"address.zip":[{"errors":"invalid"},{"errors":"too_long"}],` +
+		`"items[2]":{"errors":"out_of_stock"},"items[2].qty":{"errors":"min"},"name":[{"errors":"required"},{"errors":"too_short"}]}`
+	if got := e.Error(); got != wantJSON {
+		t.Errorf("Error() = %v, want %v", got, wantJSON)
+	}
+
+	for _, format := range []JSONFormat{JSONCompact, JSONFull} {
+		b, err := json.Marshal(e.WithFormat(format))
+		if err != nil {
+			t.Fatal(err)
+		}
+		restored := &I18nMultipleError{}
+		if err = json.Unmarshal(b, restored); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(restored.Fields(), wantFields) || len(restored.FieldErrors()["name"]) != 2 {
+			t.Errorf("format %v round trip = %v", format, restored.FieldErrors())
+		}
+	}
+
+	if got := len(e.Unwrap()); got != 7 {
+		t.Errorf("Unwrap() = %v errors, want 7", got)
+	}
+}
+
+func TestI18nMultipleError_With(t *testing.T) {
+	src := NewMultipleErr("email", "errors", "required")
+	locale := "cz"
+
+	tests := []struct {
+		name string
+		got  *I18nMultipleError
+		want *I18nMultipleError
+	}{
+		{
+			name: "code",
+			got:  src.WithCode(422),
+			want: &I18nMultipleError{code: 422, errors: src.errors},
+		},
+		{
+			name: "locale",
+			got:  src.WithLocale("cz"),
+			want: &I18nMultipleError{locale: &locale, errors: src.errors},
+		},
+		{
+			name: "format",
+			got:  src.WithFormat(JSONFull),
+			want: &I18nMultipleError{format: JSONFull, errors: src.errors},
+		},
+		{
+			name: "labels",
+			got:  src.WithLabels("form.fields"),
+			want: &I18nMultipleError{labels: "form.fields", errors: src.errors},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got == src || !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("With() = %+v, want copy %+v", tt.got, tt.want)
+			}
+			tt.got.Add("name", "errors", "required")
+		})
+	}
+
+	want := &I18nMultipleError{errors: map[string][]*BaseError{
+		"email": {{section: "errors", key: "required"}},
+	}}
+	if !reflect.DeepEqual(src, want) {
+		t.Errorf("With() changed source error = %+v, want %+v", src, want)
+	}
+}
+
+func TestI18nMultipleError_MarshalJSON_NotInitialized(t *testing.T) {
+	prev := current.Swap(nil)
+	defer current.Store(prev)
+
+	e := NewMultipleErr("email", "errors", "required").WithLocale("en")
+	if got, want := e.Error(), `{"email":{"errors":"required"}}`; got != want {
+		t.Errorf("Error() = %s, want %s", got, want)
+	}
+}
+
+func TestFieldPath(t *testing.T) {
+	tests := []struct {
+		parts []interface{}
+		want  string
+	}{
+		{[]interface{}{"address", "street"}, "address.street"},
+		{[]interface{}{"items", 2, "qty"}, "items[2].qty"},
+		{[]interface{}{"matrix", 0, 1}, "matrix[0][1]"},
+		{[]interface{}{"user", "_summary"}, "user"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FieldPath(tt.parts...); got != tt.want {
+				t.Errorf("FieldPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
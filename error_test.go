@@ -2,6 +2,8 @@ package i18n
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -120,6 +122,12 @@ func TestBaseError_UnmarshalJSON(t *testing.T) {
 			want:    BaseError{section: "section", key: "key"},
 			wantErr: false,
 		},
+		{
+			name:    "Compact JSON",
+			jsonStr: `{"user_section":"error_key"}`,
+			want:    BaseError{section: "user_section", key: "error_key"},
+			wantErr: false,
+		},
 		{
 			name:    "Invalid JSON",
 			jsonStr: `{"s":"section","v":{"value1":"test","value2":10}`,
@@ -252,3 +260,278 @@ func TestNestedI18nError_EmptyLocale_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestI18nError_Is(t *testing.T) {
+	errNotFound := NewErrWithCode(404, "errors", "not_found")
+	cause := errors.New("sql: no rows in result set")
+
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{
+			name:   "same section and key, other values",
+			err:    NewErr("errors", "not_found", M{"{id}": 1}),
+			target: errNotFound,
+			want:   true,
+		},
+		{
+			name:   "other key",
+			err:    NewErr("errors", "forbidden"),
+			target: errNotFound,
+			want:   false,
+		},
+		{
+			name:   "wrapped by fmt",
+			err:    fmt.Errorf("load user: %w", errNotFound.Wrap(cause)),
+			target: errNotFound,
+			want:   true,
+		},
+		{
+			name:   "cause",
+			err:    errNotFound.Wrap(cause),
+			target: cause,
+			want:   true,
+		},
+		{
+			name:   "WrapErr cause",
+			err:    WrapErr(cause, "errors", "not_found"),
+			target: cause,
+			want:   true,
+		},
+		{
+			name:   "multiple error field",
+			err:    NewMultipleErr("id", "errors", "not_found").Add("name", "errors", "required"),
+			target: errNotFound,
+			want:   true,
+		},
+		{
+			name:   "multiple error other fields",
+			err:    NewMultipleErr("name", "errors", "required"),
+			target: errNotFound,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestI18nError_Wrap(t *testing.T) {
+	errNotFound := NewErrWithCode(404, "errors", "not_found")
+	cause := errors.New("no rows")
+
+	wrapped := errNotFound.Wrap(cause)
+	wrapped.SetValues(M{"{id}": 1})
+	if errNotFound.Unwrap() != nil || errNotFound.values != nil {
+		t.Errorf("Wrap() changed receiver: cause = %v, values = %v", errNotFound.Unwrap(), errNotFound.values)
+	}
+	if wrapped.Unwrap() != cause || wrapped.Code() != 404 || wrapped.Error() != "errors.not_found" {
+		t.Errorf("Wrap() = %v %v %v", wrapped.Unwrap(), wrapped.Code(), wrapped)
+	}
+
+	var multiple error = NewMultipleErr("id", "errors", "not_found").Add("name", "errors", "required").WithLocale("en")
+	var fieldErr *I18nError
+	if !errors.As(multiple, &fieldErr) || fieldErr.Key() != "not_found" || fieldErr.Code() != 400 {
+		t.Errorf("errors.As() = %v", fieldErr)
+	}
+}
+
+func TestI18nError_MarshalJSON_Formats(t *testing.T) {
+	err := Init("en", &DictionaryCollection{
+		"en": {"errors": {"quoted": `Say "hi" \ {name}`, "limit": "At most {limit}"}},
+		"cz": {"errors": {"quoted": `Řekni "ahoj" \ {name}`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	catalog := NewCatalog()
+	limitDef := catalog.Register("RATE-0001", 429, "errors", "limit", "")
+
+	tests := []struct {
+		name string
+		err  *I18nError
+		want string
+	}{
+		{
+			name: "compact escaped",
+			err:  NewErr(`err"ors`, `k\ey`),
+			want: `{"err\"ors":"k\\ey"}`,
+		},
+		{
+			name: "translated escaped",
+			err:  NewErr("errors", "quoted", M{"{name}": "Bob"}).WithLocale("cz"),
+			want: `"Řekni \"ahoj\" \\ Bob"`,
+		},
+		{
+			name: "full",
+			err:  NewErrWithCode(400, "errors", "quoted", M{"{name}": "Bob"}).WithLocale("cz").WithFormat(JSONFull),
+			want: `{"s":"errors","k":"quoted","v":{"{name}":"Bob"},"c":400,"l":"cz"}`,
+		},
+		{
+			name: "translated with metadata",
+			err:  limitDef.New(M{"{limit}": 5}).WithFormat(JSONTranslated),
+			want: `{"message":"At most 5","code":429,"id":"RATE-0001"}`,
+		},
+		{
+			name: "translated with metadata to locale",
+			err:  NewErr("errors", "quoted", M{"{name}": "Bob"}).WithLocale("cz").WithFormat(JSONTranslated),
+			want: `{"message":"Řekni \"ahoj\" \\ Bob"}`,
+		},
+		{
+			name: "empty full",
+			err:  (&I18nError{}).WithFormat(JSONFull),
+			want: `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.err)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("I18nError.MarshalJSON() = %s, want %s", got, tt.want)
+			}
+			if !json.Valid(got) {
+				t.Errorf("I18nError.MarshalJSON() = %s is not valid JSON", got)
+			}
+		})
+	}
+}
+
+func TestI18nError_UnmarshalJSON(t *testing.T) {
+	if err := initDict(); err != nil {
+		t.Fatal(err)
+	}
+	locale := "cz"
+	tests := []struct {
+		name string
+		data string
+		want *I18nError
+	}{
+		{
+			name: "compact",
+			data: `{"errors":"not_found"}`,
+			want: &I18nError{BaseError: &BaseError{section: "errors", key: "not_found"}},
+		},
+		{
+			name: "full",
+			data: `{"s":"errors","k":"min","v":{"{min}":3},"c":400,"l":"cz"}`,
+			want: &I18nError{
+				BaseError: &BaseError{section: "errors", key: "min", values: map[string]interface{}{"{min}": 3.0}},
+				code:      400,
+				locale:    &locale,
+				format:    JSONFull,
+			},
+		},
+		{
+			name: "translated",
+			data: `"Say \"hi\""`,
+			want: &I18nError{BaseError: &BaseError{section: messageErrSection, key: `Say "hi"`}},
+		},
+		{
+			name: "translated with metadata",
+			data: `{"message":"Too many requests","code":429,"id":"RATE-0001"}`,
+			want: &I18nError{
+				BaseError: &BaseError{section: messageErrSection, key: "Too many requests"},
+				code:      429,
+				id:        "RATE-0001",
+				format:    JSONTranslated,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &I18nError{}
+			if err := json.Unmarshal([]byte(tt.data), got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("I18nError.UnmarshalJSON() = %+v, want %+v", got, tt.want)
+			}
+			// Restored error is encoded in the same form
+			if b, err := json.Marshal(got); err != nil || string(b) != tt.data {
+				t.Errorf("I18nError.MarshalJSON() = %s, %v, want %s", b, err, tt.data)
+			}
+		})
+	}
+
+	if err := json.Unmarshal([]byte(`[1]`), &I18nError{}); err == nil {
+		t.Error("I18nError.UnmarshalJSON() error = nil, want error")
+	}
+
+	e := NewErr("errors", "not_found")
+	if err := e.UnmarshalJSON([]byte(`null`)); err != nil || e.Section() != "errors" || e.Key() != "not_found" {
+		t.Errorf("I18nError.UnmarshalJSON(null) = %v, %v, want unchanged error", e, err)
+	}
+	var ptr *I18nError
+	if err := json.Unmarshal([]byte(`null`), &ptr); err != nil || ptr != nil {
+		t.Errorf("json.Unmarshal(null) = %v, %v, want nil error", ptr, err)
+	}
+}
+
+func TestI18nError_T_Restored(t *testing.T) {
+	if err := initDict(); err != nil {
+		t.Fatal(err)
+	}
+	tr := Get("en")
+	e := &I18nError{}
+	if err := json.Unmarshal([]byte(`"Ahoj {name}"`), e); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		got  string
+	}{
+		{"T", e.T(tr)},
+		{"Tf", e.Tf(tr)},
+		{"ErrT", e.ErrT(tr).Error()},
+		{"ErrTf", e.ErrTf(tr).Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != "Ahoj {name}" {
+				t.Errorf("%s() = %s, want Ahoj {name}", tt.name, tt.got)
+			}
+		})
+	}
+}
+
+func TestI18nError_MarshalJSON_NotInitialized(t *testing.T) {
+	prev := current.Swap(nil)
+	defer current.Store(prev)
+
+	e := NewErrWithCode(404, "errors", "not_found").WithLocale("en")
+	tests := []struct {
+		name string
+		err  *I18nError
+		want string
+	}{
+		{"compact", e, `{"errors":"not_found"}`},
+		{"translated", e.WithFormat(JSONTranslated), `{"message":"errors.not_found","code":404}`},
+		{"full", e.WithFormat(JSONFull), `{"s":"errors","k":"not_found","c":404,"l":"en"}`},
+		{"restored", &I18nError{BaseError: &BaseError{section: messageErrSection, key: "Ahoj"}}, `"Ahoj"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.err)
+			if err != nil || string(b) != tt.want {
+				t.Errorf("MarshalJSON() = %s, %v, want %s", b, err, tt.want)
+			}
+		})
+	}
+	if got := e.Error(); got != "errors.not_found" {
+		t.Errorf("Error() = %s, want errors.not_found", got)
+	}
+}
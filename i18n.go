@@ -5,9 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"reflect"
-	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 const dictExtension = "json"
@@ -24,18 +23,41 @@ type DictionaryCollection map[string]*Dictionary
 type M map[string]interface{}
 
 type Translator struct {
-	localeDictionary *Dictionary
+	locale   string
+	format   *localeFormat
+	messages catalog
+	// base is set for overlay translators, missing entries are looked up there
+	base *Translator
+	// binary is set for translators loaded by InitFromBinary
+	binary *binaryLocale
 }
 
 type TranslatorCollection map[string]*Translator
 
+// snapshot Immutable set of loaded translators.
+// Published atomically, so readers never take a lock
+type snapshot struct {
+	defLocale        string
+	availableLocales []string
+	translators      TranslatorCollection
+	// overlays "tenant" => overriding dictionaries, tenants are overlays compiled on top of translators
+	overlays map[string]*DictionaryCollection
+	tenants  map[string]TranslatorCollection
+	// lazy loads locales on first use, when initialized with InitLazy
+	lazy *lazyLoader
+}
+
 var (
-	mu                    sync.RWMutex
-	defLocale             string
-	availableLocales      []string
-	translatorsCollection TranslatorCollection
+	// mu serializes writers, readers use current only
+	mu      sync.Mutex
+	current atomic.Pointer[snapshot]
 )
 
+// load Returns current snapshot or nil, if translator not initialized
+func load() *snapshot {
+	return current.Load()
+}
+
 // InitFromDir
 // Initialize dictionaries from JSON files
 // Where file name is translation name:
@@ -79,36 +101,37 @@ func InitFromDir(defaultLocale, translationsPath string, locales ...string) erro
 	mu.Lock()
 	defer mu.Unlock()
 
-	defaultLocale = defaultLocale
+	snap := &snapshot{
+		defLocale: defaultLocale,
+	}
 	if len(locales) > 0 {
-		availableLocales = locales
+		snap.availableLocales = locales
 	} else {
-		availableLocales = getFilesFromDir(translationsPath)
+		snap.availableLocales = getFilesFromDir(translationsPath)
 	}
 
-	localePath := translationsPath
-	translatorsCollection = make(map[string]*Translator)
-	for _, locale := range availableLocales {
-		file, err := os.Open(localePath + `/` + locale + `.json`)
+	snap.translators = make(TranslatorCollection)
+	for _, locale := range snap.availableLocales {
+		tmp, err := loadDictionaryFile(translationsPath, locale)
 		if err != nil {
 			return err
 		}
-		tmp := &Dictionary{}
-		err = json.NewDecoder(file).Decode(&tmp)
-		_ = file.Close()
+		tr, err := newTranslator(locale, tmp)
 		if err != nil {
 			return err
 		}
-		tr := &Translator{
-			localeDictionary: tmp,
-		}
-		translatorsCollection[locale] = tr
+		snap.translators[locale] = tr
 	}
 
-	if _, ok := translatorsCollection[defaultLocale]; !ok {
+	if _, ok := snap.translators[defaultLocale]; !ok {
 		return errors.New("no dictionary for default language")
 	}
 
+	if err := snap.applyOverlays(load()); err != nil {
+		return err
+	}
+
+	current.Store(snap)
 	return nil
 }
 
@@ -133,30 +156,39 @@ func Init(defaultLocale string, dictCollection *DictionaryCollection, locales ..
 	mu.Lock()
 	defer mu.Unlock()
 
-	defLocale = defaultLocale
 	if _, ok := (*dictCollection)[defaultLocale]; !ok {
 		return errors.New("no dictionary for default language")
 	}
 
+	snap := &snapshot{
+		defLocale: defaultLocale,
+	}
 	if len(locales) > 0 {
-		availableLocales = locales
+		snap.availableLocales = locales
 	} else {
-		availableLocales = dictCollection.getLocales()
+		snap.availableLocales = dictCollection.getLocales()
 	}
 
-	if len(availableLocales) == 0 {
+	if len(snap.availableLocales) == 0 {
 		return errors.New("available locales not set")
 	}
 
-	translatorsCollection = make(TranslatorCollection)
-	for _, locale := range availableLocales {
+	snap.translators = make(TranslatorCollection)
+	for _, locale := range snap.availableLocales {
 		if dict, ok := (*dictCollection)[locale]; ok {
-			tr := &Translator{
-				localeDictionary: dict,
+			tr, err := newTranslator(locale, dict)
+			if err != nil {
+				return err
 			}
-			translatorsCollection[locale] = tr
+			snap.translators[locale] = tr
 		}
 	}
+
+	if err := snap.applyOverlays(load()); err != nil {
+		return err
+	}
+
+	current.Store(snap)
 	return nil
 }
 
@@ -170,12 +202,64 @@ func (c *DictionaryCollection) getLocales() (locales []string) {
 	return
 }
 
+// clone Returns deep copy of dictionaries collection
+func (c *DictionaryCollection) clone() *DictionaryCollection {
+	collection := make(DictionaryCollection, len(*c))
+	for locale, dict := range *c {
+		collection[locale] = dict.clone()
+	}
+	return &collection
+}
+
+// clone Returns deep copy of dictionary
+func (d *Dictionary) clone() *Dictionary {
+	dict := Dictionary{}
+	if d == nil {
+		return &dict
+	}
+	for section, entry := range *d {
+		if entry == nil {
+			continue
+		}
+		sectionCopy := make(DictionaryEntry, len(*entry))
+		for key, value := range *entry {
+			sectionCopy[key] = value
+		}
+		dict[section] = &sectionCopy
+	}
+	return &dict
+}
+
+// loadDictionaryFile Returns dictionary decoded from "locale.json" file
+func loadDictionaryFile(path, locale string) (*Dictionary, error) {
+	file, err := os.Open(path + `/` + locale + `.` + dictExtension)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	dict := &Dictionary{}
+	if err = json.NewDecoder(file).Decode(&dict); err != nil {
+		return nil, err
+	}
+	return dict, nil
+}
+
 // getFilesFromDir Returns available locales for dictionary
 func getFilesFromDir(path string) (locales []string) {
-	files, err := os.ReadDir(path)
+	locales, err := listDictionaryFiles(path)
 	if err != nil {
 		panic(err)
 	}
+	return
+}
+
+// listDictionaryFiles Returns locales of dictionary files in directory
+func listDictionaryFiles(path string) (locales []string, err error) {
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
 	for _, file := range files {
 		if !file.IsDir() {
 			suffixLen := len(dictExtension) + 1
@@ -190,18 +274,33 @@ func getFilesFromDir(path string) (locales []string) {
 
 // Get Returns Translator instance, if `locale` translatorsCollection exists.
 // If translatorsCollection does not exist, returns translatorsCollection for default locale.
+// Get never locks, it reads the latest published snapshot,
+// except for the first use of lazily loaded locale.
 func Get(locale string) *Translator {
-	mu.Lock()
-	defer mu.Unlock()
-
-	if translatorsCollection == nil {
+	tr := getTranslator(locale)
+	if tr == nil {
 		panic("translator not initialized")
 	}
-	if _, ok := translatorsCollection[locale]; ok {
-		return translatorsCollection[locale]
+	return tr
+}
+
+// getTranslator Returns Translator as Get, nil when translator not initialized,
+// for callers which must not panic, e.g. logging
+func getTranslator(locale string) *Translator {
+	snap := load()
+	if snap == nil {
+		return nil
+	}
+	if tr, ok := snap.translators[locale]; ok {
+		return tr
 	} else {
-		if _, ok := translatorsCollection[defLocale]; ok {
-			return translatorsCollection[defLocale]
+		if snap.lazy != nil {
+			if tr, err := snap.lazy.get(locale); err == nil {
+				return tr
+			}
+		}
+		if tr, ok := snap.translators[snap.defLocale]; ok {
+			return tr
 		} else {
 			return &Translator{}
 		}
@@ -216,31 +315,64 @@ func New(locale string) *Translator {
 
 // AvailableLocales Returns loaded locales
 func AvailableLocales() []string {
-	mu.RLock()
-	defer mu.RUnlock()
+	snap := load()
+	if snap == nil {
+		return nil
+	}
 
-	return availableLocales
+	locales := make([]string, len(snap.availableLocales))
+	copy(locales, snap.availableLocales)
+	return locales
 }
 
 // DefaultLocale Returns configured default locale
 func DefaultLocale() string {
-	mu.RLock()
-	defer mu.RUnlock()
+	snap := load()
+	if snap == nil {
+		return ""
+	}
 
-	return defLocale
+	return snap.defLocale
 }
 
-// T Returns translated string
-func (tr *Translator) T(section string, key string) string {
-	mu.RLock()
-	defer mu.RUnlock()
+// newTranslator Returns Translator with dictionary compiled for locale
+func newTranslator(locale string, dict *Dictionary) (*Translator, error) {
+	messages, err := compileDictionary(dict)
+	if err != nil {
+		return nil, fmt.Errorf("locale %s: %w", locale, err)
+	}
+	return &Translator{
+		locale:   locale,
+		format:   formatFor(locale),
+		messages: messages,
+	}, nil
+}
 
-	if _, ok := (*tr.localeDictionary)[section]; ok {
-		if entry, ok := (*(*tr.localeDictionary)[section])[key]; ok {
-			return entry
-		} else {
-			return section + `.` + key
+// lookup Returns compiled message, overlay entries take priority over base ones
+func (tr *Translator) lookup(section, key string) (*message, bool) {
+	if m, ok := tr.messages.lookup(section, key); ok {
+		return m, true
+	}
+	if tr.binary != nil {
+		if m, ok := tr.binary.lookup(section, key); ok {
+			return m, true
 		}
+	}
+	if tr.base != nil {
+		return tr.base.lookup(section, key)
+	}
+	return nil, false
+}
+
+// Locale Returns translator locale
+func (tr *Translator) Locale() string {
+	return tr.locale
+}
+
+// T Returns translated string
+func (tr *Translator) T(section string, key string) string {
+	if m, ok := tr.lookup(section, key); ok {
+		return m.raw
 	} else {
 		return section + `.` + key
 	}
@@ -248,28 +380,31 @@ func (tr *Translator) T(section string, key string) string {
 
 // Tf Returns translated formatted string
 func (tr *Translator) Tf(section string, key string, values M) string {
-	mu.RLock()
-	defer mu.RUnlock()
-
-	if tr, ok := (*(*tr.localeDictionary)[section])[key]; ok {
-		for key, value := range values {
-			switch reflect.TypeOf(value).Kind() {
-			case reflect.String:
-				tr = strings.Replace(tr, key, value.(string), -1)
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-				tr = strings.Replace(tr, key, fmt.Sprintf("%d", value), -1)
-			case reflect.Float32, reflect.Float64:
-				tr = strings.Replace(tr, key, fmt.Sprintf("%f", value), -1)
-			default:
-				tr = strings.Replace(tr, key, fmt.Sprintf("%v", value), -1)
-			}
-		}
-		return tr
+	if m, ok := tr.lookup(section, key); ok {
+		return m.render(tr, values)
 	} else {
 		return section + `.` + key
 	}
 }
 
+// AppendT Appends translated string to dst
+func (tr *Translator) AppendT(dst []byte, section string, key string) []byte {
+	if m, ok := tr.lookup(section, key); ok {
+		return append(dst, m.raw...)
+	} else {
+		return append(append(append(dst, section...), '.'), key...)
+	}
+}
+
+// AppendTf Appends translated formatted string to dst, e.g. for zero allocation logging
+func (tr *Translator) AppendTf(dst []byte, section string, key string, values M) []byte {
+	if m, ok := tr.lookup(section, key); ok {
+		return m.appendTo(dst, tr, values)
+	} else {
+		return append(append(append(dst, section...), '.'), key...)
+	}
+}
+
 // ErrT Returns translated error
 func (tr *Translator) ErrT(section string, key string) error {
 	return errors.New(tr.T(section, key))
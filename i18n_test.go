@@ -2,6 +2,7 @@ package i18n
 
 import (
 	"encoding/json"
+	"sync"
 	"testing"
 )
 
@@ -116,11 +117,11 @@ func TestI18nMultipleError_WithLocale_MarshalJSON(t *testing.T) {
 			setErr: func() *I18nMultipleError {
 				return &I18nMultipleError{
 					locale: &testDefaultLocale,
-					errors: map[string]*BaseError{
-						"field1": {
+					errors: map[string][]*BaseError{
+						"field1": {{
 							section: "fields.errors",
 							key:     "to_short",
-						},
+						}},
 					},
 				}
 			},
@@ -131,11 +132,11 @@ func TestI18nMultipleError_WithLocale_MarshalJSON(t *testing.T) {
 			setErr: func() *I18nMultipleError {
 				return &I18nMultipleError{
 					locale: &testDefaultLocale,
-					errors: map[string]*BaseError{
-						"field1": {
+					errors: map[string][]*BaseError{
+						"field1": {{
 							section: "fields.errors",
 							key:     "to_long",
-						},
+						}},
 					},
 				}
 			},
@@ -158,3 +159,98 @@ func TestI18nMultipleError_WithLocale_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+// lockedRegistry Reproduces the former mutex guarded read path,
+// kept as a baseline for the parallel benchmarks
+type lockedRegistry struct {
+	mu          sync.RWMutex
+	defLocale   string
+	translators TranslatorCollection
+}
+
+func (r *lockedRegistry) get(locale string) *Translator {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if tr, ok := r.translators[locale]; ok {
+		return tr
+	}
+	return r.translators[r.defLocale]
+}
+
+func (r *lockedRegistry) t(tr *Translator, section, key string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if m, ok := tr.messages[section][key]; ok {
+		return m.raw
+	}
+	return section + `.` + key
+}
+
+func TestGet_Concurrent(t *testing.T) {
+	if err := initDict(); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if got := Get("cz").T("section.sub_section", "key"); got != "Přeložené pole" && got != "Translated field" {
+					t.Errorf("Get().T() = %v", got)
+					return
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if err := initDict(); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := DefaultLocale(); got != testDefaultLocale {
+		t.Errorf("DefaultLocale() = %v, want %v", got, testDefaultLocale)
+	}
+}
+
+func BenchmarkGetT_Parallel(b *testing.B) {
+	if err := initDict(); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = Get("cz").T("section.sub_section", "key")
+		}
+	})
+}
+
+func BenchmarkGetT_Parallel_Locked(b *testing.B) {
+	if err := initDict(); err != nil {
+		b.Fatal(err)
+	}
+	snap := load()
+	r := &lockedRegistry{
+		defLocale:   snap.defLocale,
+		translators: snap.translators,
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = r.t(r.get("cz"), "section.sub_section", "key")
+		}
+	})
+}
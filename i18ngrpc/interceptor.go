@@ -0,0 +1,170 @@
+package i18ngrpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	i18n "github.com/censync/go-i18n"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataLocale Metadata key of request locale, value is a locale or Accept-Language list
+const MetadataLocale = "accept-language"
+
+// WithLocale Returns outgoing context with request locale
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataLocale, locale)
+}
+
+// LocaleFromContext Returns first locale of incoming metadata, empty when not set
+func LocaleFromContext(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, MetadataLocale)
+	if len(values) == 0 {
+		return ""
+	}
+	locale, _, _ := strings.Cut(values[0], ",")
+	locale, _, _ = strings.Cut(locale, ";")
+	return strings.TrimSpace(locale)
+}
+
+// UnaryServerInterceptor Returns interceptor converting i18n errors of handlers to statuses,
+// translated with locale of incoming metadata, see Status
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			err = serverError(ctx, err)
+		}
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor Returns stream interceptor converting i18n errors of handlers to statuses
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return serverError(ss.Context(), err)
+		}
+		return nil
+	}
+}
+
+// serverError Returns status error of i18n error, other errors are returned as is
+func serverError(ctx context.Context, err error) error {
+	if !isI18nError(err) {
+		return err
+	}
+	return Error(err, i18n.Get(LocaleFromContext(ctx)))
+}
+
+// UnaryClientInterceptor Returns interceptor forwarding locale of incoming metadata
+// to outgoing calls and restoring i18n errors of statuses, see StatusError
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(forwardLocale(ctx), method, req, reply, cc, opts...)
+		if err != nil {
+			return clientError(err)
+		}
+		return nil
+	}
+}
+
+// StreamClientInterceptor Returns stream interceptor forwarding locale of incoming metadata
+// to outgoing streams and restoring i18n errors of statuses, see StatusError
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		cs, err := streamer(forwardLocale(ctx), desc, cc, method, opts...)
+		if err != nil {
+			return nil, clientError(err)
+		}
+		return &clientStream{ClientStream: cs}, nil
+	}
+}
+
+// clientStream Client stream restoring i18n errors of statuses
+type clientStream struct {
+	grpc.ClientStream
+}
+
+// Header Returns header metadata, error is restored, see StatusError
+func (s *clientStream) Header() (metadata.MD, error) {
+	md, err := s.ClientStream.Header()
+	if err != nil {
+		return md, clientError(err)
+	}
+	return md, nil
+}
+
+// SendMsg Sends message, error is restored, see StatusError
+func (s *clientStream) SendMsg(m any) error {
+	if err := s.ClientStream.SendMsg(m); err != nil {
+		return clientError(err)
+	}
+	return nil
+}
+
+// RecvMsg Receives message, error is restored, see StatusError
+func (s *clientStream) RecvMsg(m any) error {
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return clientError(err)
+	}
+	return nil
+}
+
+// forwardLocale Returns context with locale of incoming metadata,
+// when outgoing locale is not set, e.g. for calls made by a handler
+func forwardLocale(ctx context.Context) context.Context {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(MetadataLocale)) > 0 {
+		return ctx
+	}
+	if locale := LocaleFromContext(ctx); locale != "" {
+		return WithLocale(ctx, locale)
+	}
+	return ctx
+}
+
+// StatusError Status error with i18n error restored from its details,
+// errors.As finds the i18n error, status.FromError finds the status
+type StatusError struct {
+	status *status.Status
+	err    error
+}
+
+// Error Returns status message, translated by server
+func (e *StatusError) Error() string {
+	return e.status.Message()
+}
+
+// GRPCStatus Returns status
+func (e *StatusError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// Unwrap Returns *i18n.I18nError or *i18n.I18nMultipleError
+func (e *StatusError) Unwrap() error {
+	return e.err
+}
+
+// clientError Returns *StatusError of status with i18n details, other errors are returned as is
+func clientError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	i18nErr := FromStatus(st)
+	if i18nErr == nil {
+		return err
+	}
+	return &StatusError{status: st, err: i18nErr}
+}
+
+func isI18nError(err error) bool {
+	var (
+		i18nErr     *i18n.I18nError
+		multipleErr *i18n.I18nMultipleError
+	)
+	return errors.As(err, &i18nErr) || errors.As(err, &multipleErr)
+}
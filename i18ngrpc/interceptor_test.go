@@ -0,0 +1,179 @@
+package i18ngrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	i18n "github.com/censync/go-i18n"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// healthServer Returns i18n error for any service but "ok"
+type healthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+}
+
+func (healthServer) Check(_ context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	switch req.GetService() {
+	case "ok":
+		return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+	case "plain":
+		return nil, errors.New("plain error")
+	default:
+		return nil, i18n.NewErrWithCode(http.StatusNotFound, "errors", "not_found", i18n.M{"{id}": req.GetService()})
+	}
+}
+
+func (s healthServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	resp, err := s.Check(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return stream.Send(resp)
+}
+
+func dialBufconn(t *testing.T) grpc_health_v1.HealthClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryServerInterceptor()),
+		grpc.StreamInterceptor(StreamServerInterceptor()),
+	)
+	grpc_health_v1.RegisterHealthServer(srv, healthServer{})
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(StreamClientInterceptor()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return grpc_health_v1.NewHealthClient(conn)
+}
+
+func TestInterceptors(t *testing.T) {
+	initDict(t)
+	client := dialBufconn(t)
+
+	tests := []struct {
+		name     string
+		locale   string
+		service  string
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{
+			name:     "translated",
+			locale:   "cz, en;q=0.9",
+			service:  "42",
+			wantCode: codes.NotFound,
+			wantMsg:  "Položka 42 nenalezena",
+		},
+		{
+			name:     "default locale",
+			service:  "42",
+			wantCode: codes.NotFound,
+			wantMsg:  "Item 42 not found",
+		},
+		{
+			name:     "plain error",
+			locale:   "cz",
+			service:  "plain",
+			wantCode: codes.Unknown,
+			wantMsg:  "plain error",
+		},
+		{
+			name:    "success",
+			locale:  "cz",
+			service: "ok",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.locale != "" {
+				ctx = WithLocale(ctx, tt.locale)
+			}
+			_, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: tt.service})
+			st, _ := status.FromError(err)
+			if st.Code() != tt.wantCode || st.Message() != tt.wantMsg {
+				t.Errorf("Check() = %v %v, want %v %v", st.Code(), st.Message(), tt.wantCode, tt.wantMsg)
+			}
+			if tt.service == "42" && err.Error() != tt.wantMsg {
+				t.Errorf("Check() error = %v, want %v", err, tt.wantMsg)
+			}
+
+			var i18nErr *i18n.I18nError
+			if got := errors.As(err, &i18nErr); got != (tt.service == "42") {
+				t.Errorf("errors.As() = %v", got)
+			}
+			if i18nErr != nil && (i18nErr.Key() != "not_found" || i18nErr.Code() != http.StatusNotFound) {
+				t.Errorf("restored error = %v %v", i18nErr, i18nErr.Code())
+			}
+		})
+	}
+}
+
+func TestStreamInterceptors(t *testing.T) {
+	initDict(t)
+	client := dialBufconn(t)
+
+	stream, err := client.Watch(WithLocale(context.Background(), "cz"), &grpc_health_v1.HealthCheckRequest{Service: "42"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = stream.Recv()
+	if st, _ := status.FromError(err); st.Code() != codes.NotFound || st.Message() != "Položka 42 nenalezena" {
+		t.Errorf("Recv() = %v %v", st.Code(), st.Message())
+	}
+	var i18nErr *i18n.I18nError
+	if !errors.As(err, &i18nErr) || i18nErr.Key() != "not_found" || i18nErr.Code() != http.StatusNotFound {
+		t.Errorf("Recv() error = %#v, want restored i18n error", err)
+	}
+
+	stream, err = client.Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "ok"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := stream.Recv(); err != nil || resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("Recv() = %v, %v", resp, err)
+	}
+	if _, err = stream.Recv(); err != io.EOF {
+		t.Errorf("Recv() error = %v, want io.EOF", err)
+	}
+}
+
+func TestLocaleFromContext(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataLocale, " de-DE;q=1, en"))
+	if got := LocaleFromContext(ctx); got != "de-DE" {
+		t.Errorf("LocaleFromContext() = %v, want de-DE", got)
+	}
+	if got := LocaleFromContext(context.Background()); got != "" {
+		t.Errorf("LocaleFromContext() = %v, want empty", got)
+	}
+
+	if md, _ := metadata.FromOutgoingContext(forwardLocale(ctx)); len(md.Get(MetadataLocale)) != 1 {
+		t.Errorf("forwardLocale() metadata = %v", md)
+	}
+}
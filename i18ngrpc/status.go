@@ -0,0 +1,198 @@
+// Package i18ngrpc Converts i18n errors to gRPC statuses and back
+package i18ngrpc
+
+import (
+	"net/http"
+	"strings"
+
+	i18n "github.com/censync/go-i18n"
+	"github.com/censync/go-i18n/i18npb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// CodeFromHTTP Returns gRPC code of HTTP error status code,
+// unset and non error codes are codes.Unknown, so status is always an error
+func CodeFromHTTP(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPreconditionFailed, http.StatusUnprocessableEntity:
+		return codes.FailedPrecondition
+	case http.StatusRequestedRangeNotSatisfiable:
+		return codes.OutOfRange
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case 499:
+		return codes.Canceled
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	}
+	if code >= http.StatusInternalServerError {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
+
+// HTTPFromCode Returns HTTP status code of gRPC code
+func HTTPFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Status Returns status of error, i18n errors are translated with tr or with their
+// priority locale and carry i18npb message and errdetails.LocalizedMessage details,
+// multiple errors also carry errdetails.BadRequest with field violations.
+// Other errors are converted with status.Convert.
+func Status(err error, tr *i18n.Translator) *status.Status {
+	i18nErr, multipleErr := i18n.FindErr(err)
+	switch {
+	case multipleErr != nil:
+		return multipleErrStatus(multipleErr, tr)
+	case i18nErr != nil:
+		return errStatus(i18nErr, tr)
+	default:
+		return status.Convert(err)
+	}
+}
+
+// Error Returns status error of err, see Status
+func Error(err error, tr *i18n.Translator) error {
+	if err == nil {
+		return nil
+	}
+	return Status(err, tr).Err()
+}
+
+func errStatus(e *i18n.I18nError, tr *i18n.Translator) *status.Status {
+	tr = translator(e.Locale(), tr)
+	msg := e.Error()
+	if tr != nil {
+		msg = e.Tf(tr)
+	}
+
+	st := status.New(CodeFromHTTP(e.Code()), msg)
+	details := []protoadapt.MessageV1{
+		protoadapt.MessageV1Of(e.ToProto()),
+	}
+	if tr != nil {
+		details = append(details, &errdetails.LocalizedMessage{
+			Locale:  tr.Locale(),
+			Message: msg,
+		})
+	}
+	return withDetails(st, details...)
+}
+
+func multipleErrStatus(e *i18n.I18nMultipleError, tr *i18n.Translator) *status.Status {
+	tr = translator(e.Locale(), tr)
+
+	errs := e.FieldErrors()
+	var fieldMessages map[string][]string
+	if tr != nil {
+		fieldMessages = e.FieldMessages(tr)
+	}
+	badRequest := &errdetails.BadRequest{}
+	messages := make([]string, 0, len(errs))
+	for _, field := range e.Fields() {
+		for i, fieldErr := range errs[field] {
+			description := fieldErr.Section() + "." + fieldErr.Key()
+			if tr != nil {
+				description = fieldMessages[field][i]
+			}
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: description,
+			})
+			messages = append(messages, field+": "+description)
+		}
+	}
+	msg := strings.Join(messages, "; ")
+
+	code := e.Code()
+	if code == 0 {
+		code = http.StatusBadRequest
+	}
+	st := status.New(CodeFromHTTP(code), msg)
+	details := []protoadapt.MessageV1{
+		protoadapt.MessageV1Of(e.ToProto()),
+		badRequest,
+	}
+	if tr != nil {
+		details = append(details, &errdetails.LocalizedMessage{
+			Locale:  tr.Locale(),
+			Message: msg,
+		})
+	}
+	return withDetails(st, details...)
+}
+
+// FromStatus Returns *i18n.I18nError or *i18n.I18nMultipleError restored from status details,
+// nil when status has no i18n details
+func FromStatus(st *status.Status) error {
+	for _, detail := range st.Details() {
+		switch m := detail.(type) {
+		case *i18npb.I18NError:
+			return i18n.ErrFromProto(m)
+		case *i18npb.I18NMultipleError:
+			return i18n.MultipleErrFromProto(m)
+		}
+	}
+	return nil
+}
+
+// translator Returns translator of priority locale or tr
+func translator(locale *string, tr *i18n.Translator) *i18n.Translator {
+	if locale != nil && *locale != "" {
+		return i18n.Get(*locale)
+	}
+	return tr
+}
+
+// withDetails Returns status with details, details are dropped when they cannot be encoded
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
@@ -0,0 +1,157 @@
+package i18ngrpc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	i18n "github.com/censync/go-i18n"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func initDict(tb testing.TB) {
+	tb.Helper()
+	err := i18n.Init("en", &i18n.DictionaryCollection{
+		"en": {
+			"errors": {
+				"not_found":  "Item {id} not found",
+				"min_length": "At least {min} characters",
+				"required":   "Required",
+			},
+		},
+		"cz": {
+			"errors": {
+				"not_found":  "Položka {id} nenalezena",
+				"min_length": "Alespoň {min} znaků",
+				"required":   "Povinné",
+			},
+		},
+	})
+	if err != nil {
+		tb.Fatal(err)
+	}
+}
+
+func TestCodeFromHTTP(t *testing.T) {
+	tests := []struct {
+		code int
+		want codes.Code
+	}{
+		{0, codes.Unknown},
+		{http.StatusOK, codes.Unknown},
+		{http.StatusBadRequest, codes.InvalidArgument},
+		{http.StatusNotFound, codes.NotFound},
+		{http.StatusTooManyRequests, codes.ResourceExhausted},
+		{http.StatusTeapot, codes.Unknown},
+		{http.StatusServiceUnavailable, codes.Unavailable},
+		{http.StatusBadGateway, codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.code), func(t *testing.T) {
+			if got := CodeFromHTTP(tt.code); got != tt.want {
+				t.Errorf("CodeFromHTTP() = %v, want %v", got, tt.want)
+			}
+			if tt.want != codes.Unknown && tt.want != codes.Internal {
+				if got := HTTPFromCode(tt.want); got != tt.code {
+					t.Errorf("HTTPFromCode() = %v, want %v", got, tt.code)
+				}
+			}
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	initDict(t)
+	cz := i18n.Get("cz")
+
+	st := Status(i18n.NewErrWithCode(http.StatusNotFound, "errors", "not_found", i18n.M{"{id}": 7}), cz)
+	if st.Code() != codes.NotFound || st.Message() != "Položka 7 nenalezena" {
+		t.Errorf("Status() = %v %v", st.Code(), st.Message())
+	}
+	var localized *errdetails.LocalizedMessage
+	for _, detail := range st.Details() {
+		if m, ok := detail.(*errdetails.LocalizedMessage); ok {
+			localized = m
+		}
+	}
+	if localized == nil || localized.GetLocale() != "cz" || localized.GetMessage() != "Položka 7 nenalezena" {
+		t.Errorf("Status() LocalizedMessage = %v", localized)
+	}
+
+	var restored *i18n.I18nError
+	if !errors.As(FromStatus(st), &restored) {
+		t.Fatalf("FromStatus() = %v, want *i18n.I18nError", FromStatus(st))
+	}
+	if restored.Code() != http.StatusNotFound || restored.Tf(i18n.Get("en")) != "Item 7 not found" {
+		t.Errorf("FromStatus() = %v %v", restored.Code(), restored.Tf(i18n.Get("en")))
+	}
+
+	// Priority locale of error wins over translator
+	st = Status(i18n.NewErr("errors", "required").WithLocale("en"), cz)
+	if st.Message() != "Required" {
+		t.Errorf("Status() with priority locale = %v, want Required", st.Message())
+	}
+
+	// Restored translated message is sent as is
+	restored = &i18n.I18nError{}
+	if err := restored.UnmarshalJSON([]byte(`"Ahoj"`)); err != nil {
+		t.Fatal(err)
+	}
+	if st = Status(restored, cz); st.Message() != "Ahoj" {
+		t.Errorf("Status() of restored error = %v, want Ahoj", st.Message())
+	}
+
+	// Error wrapping multiple error wins over it
+	wrapping := i18n.WrapErr(i18n.NewMultipleErr("email", "errors", "required"), "errors", "not_found", i18n.M{"{id}": 3})
+	if st = Status(wrapping, cz); st.Message() != "Položka 3 nenalezena" {
+		t.Errorf("Status() of error wrapping multiple error = %v, want Položka 3 nenalezena", st.Message())
+	}
+
+	if st = Status(errors.New("plain"), cz); st.Code() != codes.Unknown || FromStatus(st) != nil {
+		t.Errorf("Status() of plain error = %v, FromStatus() = %v", st, FromStatus(st))
+	}
+	if Error(nil, cz) != nil {
+		t.Error("Error(nil) != nil")
+	}
+}
+
+func TestStatus_Multiple(t *testing.T) {
+	initDict(t)
+
+	err := i18n.NewMultipleErr("password", "errors", "min_length", i18n.M{"{min}": 8}).
+		Add("email", "errors", "required")
+	st := Status(err, i18n.Get("cz"))
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("Status() code = %v, want InvalidArgument", st.Code())
+	}
+	if want := "email: Povinné; password: Alespoň 8 znaků"; st.Message() != want {
+		t.Errorf("Status() message = %v, want %v", st.Message(), want)
+	}
+
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		if m, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = m
+		}
+	}
+	if badRequest == nil || len(badRequest.GetFieldViolations()) != 2 {
+		t.Fatalf("Status() BadRequest = %v", badRequest)
+	}
+	if v := badRequest.GetFieldViolations()[1]; v.GetField() != "password" || v.GetDescription() != "Alespoň 8 znaků" {
+		t.Errorf("Status() FieldViolation = %v", v)
+	}
+
+	restored, ok := FromStatus(st).(*i18n.I18nMultipleError)
+	if !ok {
+		t.Fatalf("FromStatus() = %v, want *i18n.I18nMultipleError", FromStatus(st))
+	}
+	if got := restored.Errors()["password"]; got.Section() != "errors" || got.Key() != "min_length" {
+		t.Errorf("FromStatus() password error = %v", got)
+	}
+	if _, ok := status.FromError(st.Err()); !ok {
+		t.Error("Status().Err() is not status error")
+	}
+}
@@ -0,0 +1,319 @@
+// Package i18nopenapi Generates OpenAPI 3 components describing JSON wire forms of i18n errors
+package i18nopenapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	i18n "github.com/censync/go-i18n"
+)
+
+// Schema names of wire forms, referenced as "#/components/schemas/" + name
+const (
+	// SchemaError Any form of I18nError
+	SchemaError = "I18nError"
+	// SchemaErrorTranslated Translated message of I18nError with locale
+	SchemaErrorTranslated = "I18nErrorTranslated"
+	// SchemaErrorCompact Compact {"section": "key"} form of I18nError without locale
+	SchemaErrorCompact = "I18nErrorCompact"
+	// SchemaErrorFull Full {"s": section, "k": key, "v": values, "c": code, "l": locale} form of error
+	SchemaErrorFull = "I18nErrorFull"
+	// SchemaErrorTranslatedMeta Translated {"message": message, "code": code, "id": id} form of I18nError
+	SchemaErrorTranslatedMeta = "I18nErrorTranslatedMeta"
+	// SchemaMultipleError Any form of I18nMultipleError
+	SchemaMultipleError = "I18nMultipleError"
+	// SchemaMultipleErrorTranslated Translated messages of I18nMultipleError fields
+	SchemaMultipleErrorTranslated = "I18nMultipleErrorTranslated"
+	// SchemaMultipleErrorCompact Compact errors of I18nMultipleError fields
+	SchemaMultipleErrorCompact = "I18nMultipleErrorCompact"
+	// SchemaMultipleErrorFull JSONFull form of I18nMultipleError
+	SchemaMultipleErrorFull = "I18nMultipleErrorFull"
+	// SchemaTranslatedError Structured translation returned by i18n.TranslateErr
+	SchemaTranslatedError = "TranslatedError"
+)
+
+// Components OpenAPI components object
+type Components struct {
+	Schemas   map[string]*Schema   `json:"schemas"`
+	Responses map[string]*Response `json:"responses,omitempty"`
+}
+
+// Schema OpenAPI schema object, subset used by i18n errors
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	MinProperties        int                `json:"minProperties,omitempty"`
+	MaxProperties        int                `json:"maxProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Example              json.RawMessage    `json:"example,omitempty"`
+}
+
+// Response OpenAPI response object
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content"`
+}
+
+// MediaType OpenAPI media type object
+type MediaType struct {
+	Schema   *Schema             `json:"schema"`
+	Examples map[string]*Example `json:"examples,omitempty"`
+}
+
+// Example OpenAPI example object
+type Example struct {
+	Summary string          `json:"summary,omitempty"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// Generator Generates components of error schemas and example responses
+type Generator struct {
+	// Catalog Errors of example responses, i18n.DefaultCatalog when nil
+	Catalog *i18n.Catalog
+	// Locales Locales of translated examples, all loaded locales when empty
+	Locales []string
+	// MultipleExample Example of I18nMultipleError, error of required "email"
+	// in i18n.DefaultValidationSection when nil
+	MultipleExample *i18n.I18nMultipleError
+}
+
+// Components Returns schemas of wire forms with examples rendered from loaded dictionaries
+// and response for each status code of catalog errors, named "Error" + code, e.g. "Error404".
+// Catalog errors without code are not included in responses
+func (g *Generator) Components() (*Components, error) {
+	if i18n.AvailableLocales() == nil {
+		return nil, errors.New("translator not initialized")
+	}
+	locales := g.Locales
+	if len(locales) == 0 {
+		locales = i18n.AvailableLocales()
+	}
+	catalog := g.Catalog
+	if catalog == nil {
+		catalog = i18n.DefaultCatalog
+	}
+	multipleErr := g.MultipleExample
+	if multipleErr == nil {
+		multipleErr = i18n.NewMultipleEmptyErr().Add("email", i18n.DefaultValidationSection, "required")
+	}
+
+	c := &Components{
+		Schemas:   Schemas(),
+		Responses: map[string]*Response{},
+	}
+	if err := schemaExamples(c.Schemas, catalog, multipleErr, i18n.DefaultLocale()); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range catalog.Entries() {
+		code := entry.Def.Code()
+		if code == 0 {
+			continue
+		}
+		media := response(c.Responses, code)
+		media.Examples[entry.ID] = &Example{
+			Summary: entry.Description,
+		}
+		if err := setExample(&media.Examples[entry.ID].Value, entry.Def.New()); err != nil {
+			return nil, err
+		}
+		for _, locale := range locales {
+			example := &Example{Summary: entry.Description}
+			if err := setExample(&example.Value, entry.Def.New().WithLocale(locale)); err != nil {
+				return nil, err
+			}
+			media.Examples[entry.ID+"-"+locale] = example
+		}
+	}
+
+	if code := multipleErr.Code(); code != 0 {
+		media := response(c.Responses, code)
+		media.Schema.AnyOf = append(media.Schema.AnyOf, ref(SchemaMultipleError))
+		media.Examples["fields"] = &Example{}
+		if err := setExample(&media.Examples["fields"].Value, multipleErr); err != nil {
+			return nil, err
+		}
+		for _, locale := range locales {
+			example := &Example{}
+			if err := setExample(&example.Value, multipleErr.WithLocale(locale)); err != nil {
+				return nil, err
+			}
+			media.Examples["fields-"+locale] = example
+		}
+	}
+	return c, nil
+}
+
+// schemaExamples Sets examples of schemas, translated in locale
+func schemaExamples(schemas map[string]*Schema, catalog *i18n.Catalog, multipleErr *i18n.I18nMultipleError, locale string) error {
+	var def i18n.ErrorDef
+	if entries := catalog.Entries(); len(entries) > 0 {
+		def = entries[0].Def
+	} else if fieldErrs := multipleErr.FieldErrors(); len(fieldErrs) > 0 {
+		field := multipleErr.Fields()[0]
+		def = i18n.Define(fieldErrs[field][0].Section(), fieldErrs[field][0].Key())
+	}
+
+	examples := []struct {
+		name  string
+		value interface{}
+	}{
+		{SchemaErrorTranslated, def.New().WithLocale(locale)},
+		{SchemaErrorCompact, def.New()},
+		{SchemaErrorFull, def.New().WithLocale(locale).WithFormat(i18n.JSONFull)},
+		{SchemaErrorTranslatedMeta, def.New().WithLocale(locale).WithFormat(i18n.JSONTranslated)},
+		{SchemaMultipleErrorTranslated, multipleErr.WithLocale(locale).WithFormat(i18n.JSONCompact)},
+		{SchemaMultipleErrorCompact, multipleErr.WithFormat(i18n.JSONCompact)},
+		{SchemaMultipleErrorFull, multipleErr.WithFormat(i18n.JSONFull)},
+		{SchemaTranslatedError, i18n.TranslateErr(i18n.Get(locale), multipleErr)},
+	}
+	for _, example := range examples {
+		if err := setExample(&schemas[example.name].Example, example.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Schemas Returns schemas of wire forms without examples
+func Schemas() map[string]*Schema {
+	str := func(description string) *Schema {
+		return &Schema{Type: "string", Description: description}
+	}
+	oneOrMany := func(item *Schema) *Schema {
+		return &Schema{OneOf: []*Schema{item, {Type: "array", Items: item}}}
+	}
+
+	return map[string]*Schema{
+		SchemaError: {
+			Description: "Error in any wire form",
+			AnyOf: []*Schema{
+				ref(SchemaErrorTranslated),
+				ref(SchemaErrorCompact),
+				ref(SchemaErrorFull),
+				ref(SchemaErrorTranslatedMeta),
+			},
+		},
+		SchemaErrorTranslated: str("Translated error message"),
+		SchemaErrorCompact: {
+			Type:                 "object",
+			Description:          "Untranslated error, dictionary section as name and message key as value",
+			AdditionalProperties: str("Message key"),
+			MinProperties:        1,
+			MaxProperties:        1,
+		},
+		SchemaErrorFull: {
+			Type:        "object",
+			Description: "Untranslated error with values of message placeholders",
+			Properties: map[string]*Schema{
+				"s": str("Dictionary section"),
+				"k": str("Message key"),
+				"v": {
+					Type:        "object",
+					Description: `Values of placeholders, e.g. {"{min}": 3}, typed values are objects with "$" names, e.g. {"$money": 129950, "$currency": "EUR"}`,
+				},
+				"c": {Type: "integer", Description: "Status code, not set for errors of fields"},
+				"l": str("Locale, not set for errors of fields"),
+			},
+		},
+		SchemaErrorTranslatedMeta: {
+			Type:        "object",
+			Description: "Error translated to locale of error or default locale with metadata",
+			Properties: map[string]*Schema{
+				"message": str("Translated error message"),
+				"code":    {Type: "integer", Description: "Status code"},
+				"id":      str(`Stable identifier of error, e.g. "AUTH-0042"`),
+			},
+			Required: []string{"message"},
+		},
+		SchemaMultipleError: {
+			Description: "Errors of fields in any wire form",
+			AnyOf: []*Schema{
+				ref(SchemaMultipleErrorTranslated),
+				ref(SchemaMultipleErrorCompact),
+				ref(SchemaMultipleErrorFull),
+			},
+		},
+		SchemaMultipleErrorTranslated: {
+			Type: "object",
+			Description: "Translated messages by field path, e.g. \"items[2].qty\", " +
+				"field with several errors has a list, \"_summary\" holds errors not related to a field",
+			AdditionalProperties: oneOrMany(str("Translated error message")),
+		},
+		SchemaMultipleErrorCompact: {
+			Type:                 "object",
+			Description:          "Untranslated errors by field path, field with several errors has a list",
+			AdditionalProperties: oneOrMany(ref(SchemaErrorCompact)),
+		},
+		SchemaMultipleErrorFull: {
+			Type:        "object",
+			Description: "Untranslated errors with all data needed to restore them",
+			Properties: map[string]*Schema{
+				"c": {Type: "integer", Description: "Status code"},
+				"l": str("Locale"),
+				"e": {
+					Type:                 "object",
+					Description:          "Errors by field path, field with several errors has a list",
+					AdditionalProperties: oneOrMany(ref(SchemaErrorFull)),
+				},
+			},
+			Required: []string{"e"},
+		},
+		SchemaTranslatedError: {
+			Type:        "object",
+			Description: "Translated error, messages of field are joined",
+			Properties: map[string]*Schema{
+				"message": str("Translated message, field messages are joined for multiple errors"),
+				"code":    {Type: "integer", Description: "Status code"},
+				"fields": {
+					Type:                 "object",
+					Description:          "Translated messages by field path",
+					AdditionalProperties: str("Translated messages of field"),
+				},
+			},
+			Required: []string{"message"},
+		},
+	}
+}
+
+// response Returns media type of response for status code, response is created when missing
+func response(responses map[string]*Response, code int) *MediaType {
+	name := "Error" + strconv.Itoa(code)
+	if r, ok := responses[name]; ok {
+		return r.Content["application/json"]
+	}
+	description := http.StatusText(code)
+	if description == "" {
+		description = "Error " + strconv.Itoa(code)
+	}
+	media := &MediaType{
+		Schema:   &Schema{AnyOf: []*Schema{ref(SchemaError)}},
+		Examples: map[string]*Example{},
+	}
+	responses[name] = &Response{
+		Description: description,
+		Content:     map[string]*MediaType{"application/json": media},
+	}
+	return media
+}
+
+func ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+func setExample(dst *json.RawMessage, value interface{}) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	*dst = b
+	return nil
+}
@@ -0,0 +1,176 @@
+package i18nopenapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	i18n "github.com/censync/go-i18n"
+)
+
+func initDict(tb testing.TB) {
+	tb.Helper()
+	err := i18n.Init("en", &i18n.DictionaryCollection{
+		"en": {
+			"errors":            {"not_found": "Item not found", "limit": "Too many requests"},
+			"errors.validation": {"required": "{field} is required"},
+			"fields":            {"email": "E-mail"},
+			"form.fields":       {"email": "Login e-mail"},
+		},
+		"cz": {
+			"errors":            {"not_found": "Položka nenalezena", "limit": "Příliš mnoho požadavků"},
+			"errors.validation": {"required": "{field} je povinné"},
+			"fields":            {"email": "E-mail"},
+		},
+	})
+	if err != nil {
+		tb.Fatal(err)
+	}
+}
+
+func testCatalog() *i18n.Catalog {
+	c := i18n.NewCatalog()
+	c.Register("ITEM-0001", 404, "errors", "not_found", "Item does not exist")
+	c.Register("RATE-0001", 429, "errors", "limit", "Rate limit is reached")
+	c.Register("MISC-0001", 0, "errors", "other", "Error without code")
+	return c
+}
+
+func examples(t *testing.T, media *MediaType) map[string]string {
+	t.Helper()
+	values := make(map[string]string, len(media.Examples))
+	for name, example := range media.Examples {
+		values[name] = string(example.Value)
+	}
+	return values
+}
+
+func TestGenerator_Components(t *testing.T) {
+	initDict(t)
+	g := &Generator{
+		Catalog: testCatalog(),
+		Locales: []string{"en", "cz"},
+	}
+	c, err := g.Components()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		response string
+		anyOf    int
+		want     map[string]string
+	}{
+		{
+			response: "Error404",
+			anyOf:    1,
+			want: map[string]string{
+				"ITEM-0001":    `{"errors":"not_found"}`,
+				"ITEM-0001-en": `"Item not found"`,
+				"ITEM-0001-cz": `"Položka nenalezena"`,
+			},
+		},
+		{
+			response: "Error429",
+			anyOf:    1,
+			want: map[string]string{
+				"RATE-0001":    `{"errors":"limit"}`,
+				"RATE-0001-en": `"Too many requests"`,
+				"RATE-0001-cz": `"Příliš mnoho požadavků"`,
+			},
+		},
+		{
+			response: "Error400",
+			anyOf:    2,
+			want: map[string]string{
+				"fields":    `{"email":{"errors.validation":"required"}}`,
+				"fields-en": `{"email":"E-mail is required"}`,
+				"fields-cz": `{"email":"E-mail je povinné"}`,
+			},
+		},
+	}
+	if len(c.Responses) != len(tests) {
+		t.Errorf("Components() responses = %d, want %d", len(c.Responses), len(tests))
+	}
+	for _, tt := range tests {
+		t.Run(tt.response, func(t *testing.T) {
+			r, ok := c.Responses[tt.response]
+			if !ok {
+				t.Fatalf("Components() has no response %s", tt.response)
+			}
+			media := r.Content["application/json"]
+			if len(media.Schema.AnyOf) != tt.anyOf {
+				t.Errorf("Components() schema = %v", media.Schema.AnyOf)
+			}
+			if got := examples(t, media); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Components() examples = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerator_SchemaExamples(t *testing.T) {
+	initDict(t)
+	c, err := (&Generator{Catalog: testCatalog()}).Components()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		SchemaErrorTranslated:         `"Item not found"`,
+		SchemaErrorCompact:            `{"errors":"not_found"}`,
+		SchemaErrorFull:               `{"s":"errors","k":"not_found","c":404,"l":"en"}`,
+		SchemaErrorTranslatedMeta:     `{"message":"Item not found","code":404,"id":"ITEM-0001"}`,
+		SchemaMultipleErrorTranslated: `{"email":"E-mail is required"}`,
+		SchemaMultipleErrorCompact:    `{"email":{"errors.validation":"required"}}`,
+		SchemaMultipleErrorFull:       `{"c":400,"e":{"email":{"s":"errors.validation","k":"required"}}}`,
+		SchemaTranslatedError:         `{"message":"E-mail: E-mail is required","code":400,"fields":{"email":"E-mail is required"}}`,
+	}
+	for name, example := range want {
+		if got := string(c.Schemas[name].Example); got != example {
+			t.Errorf("Components() %s example = %s, want %s", name, got, example)
+		}
+	}
+
+	// Every reference resolves to a schema
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, part := range strings.Split(string(b), `"$ref":"#/components/schemas/`)[1:] {
+		name := part[:strings.IndexByte(part, '"')]
+		if _, ok := c.Schemas[name]; !ok {
+			t.Errorf("Components() has no schema %s", name)
+		}
+	}
+}
+
+func TestGenerator_MultipleExample(t *testing.T) {
+	initDict(t)
+	example := i18n.NewMultipleEmptyErr().
+		Add("email", i18n.DefaultValidationSection, "required").
+		WithLabels("form.fields")
+	c, err := (&Generator{
+		Catalog:         i18n.NewCatalog(),
+		Locales:         []string{"en"},
+		MultipleExample: example,
+	}).Components()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"fields":    `{"email":{"errors.validation":"required"}}`,
+		"fields-en": `{"email":"Login e-mail is required"}`,
+	}
+	if got := examples(t, c.Responses["Error400"].Content["application/json"]); !reflect.DeepEqual(got, want) {
+		t.Errorf("Components() examples = %v, want %v", got, want)
+	}
+	if got := string(c.Schemas[SchemaTranslatedError].Example); got != `{"message":"Login e-mail: Login e-mail is required","code":400,"fields":{"email":"Login e-mail is required"}}` {
+		t.Errorf("Components() %s example = %s", SchemaTranslatedError, got)
+	}
+	if example.Locale() != nil {
+		t.Errorf("Components() changed example locale = %v", *example.Locale())
+	}
+}
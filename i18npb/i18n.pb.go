@@ -0,0 +1,808 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: i18n.proto
+
+package i18npb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type I18NError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Section       string                 `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Code          int32                  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	Locale        string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	Values        map[string]*Value      `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *I18NError) Reset() {
+	*x = I18NError{}
+	mi := &file_i18n_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *I18NError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*I18NError) ProtoMessage() {}
+
+func (x *I18NError) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use I18NError.ProtoReflect.Descriptor instead.
+func (*I18NError) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *I18NError) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *I18NError) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *I18NError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *I18NError) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *I18NError) GetValues() map[string]*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type BaseError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Section       string                 `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Values        map[string]*Value      `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseError) Reset() {
+	*x = BaseError{}
+	mi := &file_i18n_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseError) ProtoMessage() {}
+
+func (x *BaseError) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseError.ProtoReflect.Descriptor instead.
+func (*BaseError) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BaseError) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *BaseError) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BaseError) GetValues() map[string]*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type I18NMultipleError struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Code   int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Locale string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// first error of each field
+	Errors map[string]*BaseError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// all errors of each field in order of adding
+	FieldErrors   map[string]*BaseErrors `protobuf:"bytes,4,rep,name=field_errors,json=fieldErrors,proto3" json:"field_errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *I18NMultipleError) Reset() {
+	*x = I18NMultipleError{}
+	mi := &file_i18n_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *I18NMultipleError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*I18NMultipleError) ProtoMessage() {}
+
+func (x *I18NMultipleError) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use I18NMultipleError.ProtoReflect.Descriptor instead.
+func (*I18NMultipleError) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *I18NMultipleError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *I18NMultipleError) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *I18NMultipleError) GetErrors() map[string]*BaseError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *I18NMultipleError) GetFieldErrors() map[string]*BaseErrors {
+	if x != nil {
+		return x.FieldErrors
+	}
+	return nil
+}
+
+type BaseErrors struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Errors        []*BaseError           `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseErrors) Reset() {
+	*x = BaseErrors{}
+	mi := &file_i18n_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseErrors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseErrors) ProtoMessage() {}
+
+func (x *BaseErrors) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseErrors.ProtoReflect.Descriptor instead.
+func (*BaseErrors) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BaseErrors) GetErrors() []*BaseError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Value Placeholder value, plain Go values and typed i18n arguments
+type Value struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Value_StringValue
+	//	*Value_IntValue
+	//	*Value_UintValue
+	//	*Value_DoubleValue
+	//	*Value_BoolValue
+	//	*Value_MessageArg
+	//	*Value_DateArg
+	//	*Value_MoneyArg
+	//	*Value_IntArg
+	//	*Value_FloatArg
+	Kind          isValue_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	mi := &file_i18n_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Value) GetKind() isValue_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Value) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *Value) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetUintValue() uint64 {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_UintValue); ok {
+			return x.UintValue
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetDoubleValue() float64 {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_DoubleValue); ok {
+			return x.DoubleValue
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *Value) GetMessageArg() *Message {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_MessageArg); ok {
+			return x.MessageArg
+		}
+	}
+	return nil
+}
+
+func (x *Value) GetDateArg() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_DateArg); ok {
+			return x.DateArg
+		}
+	}
+	return nil
+}
+
+func (x *Value) GetMoneyArg() *Money {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_MoneyArg); ok {
+			return x.MoneyArg
+		}
+	}
+	return nil
+}
+
+func (x *Value) GetIntArg() int64 {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_IntArg); ok {
+			return x.IntArg
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetFloatArg() *Float {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_FloatArg); ok {
+			return x.FloatArg
+		}
+	}
+	return nil
+}
+
+type isValue_Kind interface {
+	isValue_Kind()
+}
+
+type Value_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type Value_IntValue struct {
+	IntValue int64 `protobuf:"zigzag64,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type Value_UintValue struct {
+	UintValue uint64 `protobuf:"varint,3,opt,name=uint_value,json=uintValue,proto3,oneof"`
+}
+
+type Value_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,4,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type Value_BoolValue struct {
+	BoolValue bool `protobuf:"varint,5,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type Value_MessageArg struct {
+	// i18n.Msg
+	MessageArg *Message `protobuf:"bytes,6,opt,name=message_arg,json=messageArg,proto3,oneof"`
+}
+
+type Value_DateArg struct {
+	// i18n.Date
+	DateArg *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date_arg,json=dateArg,proto3,oneof"`
+}
+
+type Value_MoneyArg struct {
+	// i18n.Money
+	MoneyArg *Money `protobuf:"bytes,8,opt,name=money_arg,json=moneyArg,proto3,oneof"`
+}
+
+type Value_IntArg struct {
+	// i18n.Int
+	IntArg int64 `protobuf:"zigzag64,9,opt,name=int_arg,json=intArg,proto3,oneof"`
+}
+
+type Value_FloatArg struct {
+	// i18n.Float
+	FloatArg *Float `protobuf:"bytes,10,opt,name=float_arg,json=floatArg,proto3,oneof"`
+}
+
+func (*Value_StringValue) isValue_Kind() {}
+
+func (*Value_IntValue) isValue_Kind() {}
+
+func (*Value_UintValue) isValue_Kind() {}
+
+func (*Value_DoubleValue) isValue_Kind() {}
+
+func (*Value_BoolValue) isValue_Kind() {}
+
+func (*Value_MessageArg) isValue_Kind() {}
+
+func (*Value_DateArg) isValue_Kind() {}
+
+func (*Value_MoneyArg) isValue_Kind() {}
+
+func (*Value_IntArg) isValue_Kind() {}
+
+func (*Value_FloatArg) isValue_Kind() {}
+
+type Message struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Section       string                 `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Values        map[string]*Value      `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_i18n_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Message) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Message) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Message) GetValues() map[string]*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// amount in minor units of currency
+	Amount        int64  `protobuf:"zigzag64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_i18n_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Float struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Precision     int32                  `protobuf:"varint,2,opt,name=precision,proto3" json:"precision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Float) Reset() {
+	*x = Float{}
+	mi := &file_i18n_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Float) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Float) ProtoMessage() {}
+
+func (x *Float) ProtoReflect() protoreflect.Message {
+	mi := &file_i18n_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Float.ProtoReflect.Descriptor instead.
+func (*Float) Descriptor() ([]byte, []int) {
+	return file_i18n_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Float) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Float) GetPrecision() int32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+var File_i18n_proto protoreflect.FileDescriptor
+
+const file_i18n_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"i18n.proto\x12\x04i18n\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x01\n" +
+	"\tI18nError\x12\x18\n" +
+	"\asection\x18\x01 \x01(\tR\asection\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\x05R\x04code\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06locale\x123\n" +
+	"\x06values\x18\x06 \x03(\v2\x1b.i18n.I18nError.ValuesEntryR\x06values\x1aF\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12!\n" +
+	"\x05value\x18\x02 \x01(\v2\v.i18n.ValueR\x05value:\x028\x01J\x04\b\x03\x10\x04\"\xba\x01\n" +
+	"\tbaseError\x12\x18\n" +
+	"\asection\x18\x01 \x01(\tR\asection\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x123\n" +
+	"\x06values\x18\x04 \x03(\v2\x1b.i18n.baseError.ValuesEntryR\x06values\x1aF\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12!\n" +
+	"\x05value\x18\x02 \x01(\v2\v.i18n.ValueR\x05value:\x028\x01J\x04\b\x03\x10\x04\"\xe7\x02\n" +
+	"\x11I18nMultipleError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12;\n" +
+	"\x06errors\x18\x03 \x03(\v2#.i18n.I18nMultipleError.ErrorsEntryR\x06errors\x12K\n" +
+	"\ffield_errors\x18\x04 \x03(\v2(.i18n.I18nMultipleError.FieldErrorsEntryR\vfieldErrors\x1aJ\n" +
+	"\vErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12%\n" +
+	"\x05value\x18\x02 \x01(\v2\x0f.i18n.baseErrorR\x05value:\x028\x01\x1aP\n" +
+	"\x10FieldErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12&\n" +
+	"\x05value\x18\x02 \x01(\v2\x10.i18n.baseErrorsR\x05value:\x028\x01\"5\n" +
+	"\n" +
+	"baseErrors\x12'\n" +
+	"\x06errors\x18\x01 \x03(\v2\x0f.i18n.baseErrorR\x06errors\"\x98\x03\n" +
+	"\x05Value\x12#\n" +
+	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12\x1d\n" +
+	"\tint_value\x18\x02 \x01(\x12H\x00R\bintValue\x12\x1f\n" +
+	"\n" +
+	"uint_value\x18\x03 \x01(\x04H\x00R\tuintValue\x12#\n" +
+	"\fdouble_value\x18\x04 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x05 \x01(\bH\x00R\tboolValue\x120\n" +
+	"\vmessage_arg\x18\x06 \x01(\v2\r.i18n.MessageH\x00R\n" +
+	"messageArg\x127\n" +
+	"\bdate_arg\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\adateArg\x12*\n" +
+	"\tmoney_arg\x18\b \x01(\v2\v.i18n.MoneyH\x00R\bmoneyArg\x12\x19\n" +
+	"\aint_arg\x18\t \x01(\x12H\x00R\x06intArg\x12*\n" +
+	"\tfloat_arg\x18\n" +
+	" \x01(\v2\v.i18n.FloatH\x00R\bfloatArgB\x06\n" +
+	"\x04kind\"\xb0\x01\n" +
+	"\aMessage\x12\x18\n" +
+	"\asection\x18\x01 \x01(\tR\asection\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x121\n" +
+	"\x06values\x18\x03 \x03(\v2\x19.i18n.Message.ValuesEntryR\x06values\x1aF\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12!\n" +
+	"\x05value\x18\x02 \x01(\v2\v.i18n.ValueR\x05value:\x028\x01\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x12R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\";\n" +
+	"\x05Float\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x1c\n" +
+	"\tprecision\x18\x02 \x01(\x05R\tprecisionB*Z(github.com/censync/go-i18n/i18npb;i18npbb\x06proto3"
+
+var (
+	file_i18n_proto_rawDescOnce sync.Once
+	file_i18n_proto_rawDescData []byte
+)
+
+func file_i18n_proto_rawDescGZIP() []byte {
+	file_i18n_proto_rawDescOnce.Do(func() {
+		file_i18n_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_i18n_proto_rawDesc), len(file_i18n_proto_rawDesc)))
+	})
+	return file_i18n_proto_rawDescData
+}
+
+var file_i18n_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_i18n_proto_goTypes = []any{
+	(*I18NError)(nil),             // 0: i18n.I18nError
+	(*BaseError)(nil),             // 1: i18n.baseError
+	(*I18NMultipleError)(nil),     // 2: i18n.I18nMultipleError
+	(*BaseErrors)(nil),            // 3: i18n.baseErrors
+	(*Value)(nil),                 // 4: i18n.Value
+	(*Message)(nil),               // 5: i18n.Message
+	(*Money)(nil),                 // 6: i18n.Money
+	(*Float)(nil),                 // 7: i18n.Float
+	nil,                           // 8: i18n.I18nError.ValuesEntry
+	nil,                           // 9: i18n.baseError.ValuesEntry
+	nil,                           // 10: i18n.I18nMultipleError.ErrorsEntry
+	nil,                           // 11: i18n.I18nMultipleError.FieldErrorsEntry
+	nil,                           // 12: i18n.Message.ValuesEntry
+	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+}
+var file_i18n_proto_depIdxs = []int32{
+	8,  // 0: i18n.I18nError.values:type_name -> i18n.I18nError.ValuesEntry
+	9,  // 1: i18n.baseError.values:type_name -> i18n.baseError.ValuesEntry
+	10, // 2: i18n.I18nMultipleError.errors:type_name -> i18n.I18nMultipleError.ErrorsEntry
+	11, // 3: i18n.I18nMultipleError.field_errors:type_name -> i18n.I18nMultipleError.FieldErrorsEntry
+	1,  // 4: i18n.baseErrors.errors:type_name -> i18n.baseError
+	5,  // 5: i18n.Value.message_arg:type_name -> i18n.Message
+	13, // 6: i18n.Value.date_arg:type_name -> google.protobuf.Timestamp
+	6,  // 7: i18n.Value.money_arg:type_name -> i18n.Money
+	7,  // 8: i18n.Value.float_arg:type_name -> i18n.Float
+	12, // 9: i18n.Message.values:type_name -> i18n.Message.ValuesEntry
+	4,  // 10: i18n.I18nError.ValuesEntry.value:type_name -> i18n.Value
+	4,  // 11: i18n.baseError.ValuesEntry.value:type_name -> i18n.Value
+	1,  // 12: i18n.I18nMultipleError.ErrorsEntry.value:type_name -> i18n.baseError
+	3,  // 13: i18n.I18nMultipleError.FieldErrorsEntry.value:type_name -> i18n.baseErrors
+	4,  // 14: i18n.Message.ValuesEntry.value:type_name -> i18n.Value
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_i18n_proto_init() }
+func file_i18n_proto_init() {
+	if File_i18n_proto != nil {
+		return
+	}
+	file_i18n_proto_msgTypes[4].OneofWrappers = []any{
+		(*Value_StringValue)(nil),
+		(*Value_IntValue)(nil),
+		(*Value_UintValue)(nil),
+		(*Value_DoubleValue)(nil),
+		(*Value_BoolValue)(nil),
+		(*Value_MessageArg)(nil),
+		(*Value_DateArg)(nil),
+		(*Value_MoneyArg)(nil),
+		(*Value_IntArg)(nil),
+		(*Value_FloatArg)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_i18n_proto_rawDesc), len(file_i18n_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_i18n_proto_goTypes,
+		DependencyIndexes: file_i18n_proto_depIdxs,
+		MessageInfos:      file_i18n_proto_msgTypes,
+	}.Build()
+	File_i18n_proto = out.File
+	file_i18n_proto_goTypes = nil
+	file_i18n_proto_depIdxs = nil
+}
@@ -0,0 +1,109 @@
+package i18n
+
+import "strings"
+
+const (
+	// DefaultLabelSection Section of field labels used to fill {field} placeholder
+	// of multiple error messages, e.g. {"fields": {"email": "E-mail"}}
+	DefaultLabelSection = "fields"
+
+	labelPlaceholder = "{field}"
+	labelName        = "field"
+)
+
+// Label Returns localized label of field path from section. Path is looked up as is,
+// without indexes and by its last name, e.g. "items[2].qty", "items.qty" and "qty",
+// path is returned when no label is found
+func (tr *Translator) Label(section string, field string) string {
+	if m, ok := tr.lookup(section, field); ok {
+		return m.raw
+	}
+	plain := stripIndexes(field)
+	if plain != field {
+		if m, ok := tr.lookup(section, plain); ok {
+			return m.raw
+		}
+	}
+	if i := strings.LastIndexByte(plain, '.'); i >= 0 {
+		if m, ok := tr.lookup(section, plain[i+1:]); ok {
+			return m.raw
+		}
+	}
+	return field
+}
+
+// stripIndexes Returns field path without indexes, e.g. "items.qty" for "items[2].qty"
+func stripIndexes(field string) string {
+	if strings.IndexByte(field, '[') < 0 {
+		return field
+	}
+	var b strings.Builder
+	b.Grow(len(field))
+	inIndex := false
+	for i := 0; i < len(field); i++ {
+		switch c := field[i]; {
+		case c == '[':
+			inIndex = true
+		case c == ']':
+			inIndex = false
+		case !inIndex:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// WithLabels Returns copy of error with section of field labels, labels fill {field} placeholder
+// of translated messages, default section is DefaultLabelSection
+func (e *I18nMultipleError) WithLabels(section string) *I18nMultipleError {
+	c := e.clone()
+	c.labels = section
+	return c
+}
+
+// label Returns localized label of field, empty for default field
+func (e *I18nMultipleError) label(tr *Translator, field string) string {
+	if field == multipleDefaultErrorField {
+		return ""
+	}
+	section := e.labels
+	if section == "" {
+		section = DefaultLabelSection
+	}
+	return tr.Label(section, field)
+}
+
+// FieldMessages Returns translated messages of each field, {field} placeholder
+// is filled with localized field label, see WithLabels
+func (e *I18nMultipleError) FieldMessages(tr *Translator) map[string][]string {
+	messages := make(map[string][]string, len(e.errors))
+	for field, fieldErrs := range e.errors {
+		label := e.label(tr, field)
+		list := make([]string, len(fieldErrs))
+		for i, fieldErr := range fieldErrs {
+			list[i] = fieldErr.translate(tr, label)
+		}
+		messages[field] = list
+	}
+	return messages
+}
+
+// withLabel Returns values with {field} set to label, values are copied,
+// explicitly set {field} or field is kept
+func withLabel(values M, label string) M {
+	if label == "" {
+		return values
+	}
+	if _, ok := values[labelPlaceholder]; ok {
+		return values
+	}
+	if _, ok := values[labelName]; ok {
+		return values
+	}
+	labeled := make(M, len(values)+1)
+	for name, value := range values {
+		labeled[name] = value
+	}
+	labeled[labelPlaceholder] = label
+	return labeled
+}
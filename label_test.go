@@ -0,0 +1,84 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTranslator_Label(t *testing.T) {
+	dict := &Dictionary{
+		"fields": {
+			"email":        "E-mail",
+			"items.qty":    "Quantity",
+			"street":       "Street",
+			"address.city": "City",
+		},
+	}
+	tr := testTranslator(t, "en", dict)
+
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"email", "E-mail"},
+		{"items[2].qty", "Quantity"},
+		{"address.street", "Street"},
+		{"address.city", "City"},
+		{"billing.address.street", "Street"},
+		{"phone", "phone"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if got := tr.Label("fields", tt.field); got != tt.want {
+				t.Errorf("Label() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestI18nMultipleError_FieldMessages(t *testing.T) {
+	dict := &Dictionary{
+		"errors": {
+			"min_length": "{field} minimum length is {min}",
+			"required":   "{field} is required",
+			"failed":     "Signup failed",
+		},
+		"fields": {
+			"username": "User name",
+		},
+		"form.signup.fields": {
+			"username": "Login",
+		},
+	}
+	tr := testTranslator(t, "en", dict)
+
+	e := NewMultipleErr("username", "errors", "min_length", M{"{min}": 3}).
+		Add("username", "errors", "required", M{"{field}": "Nickname"}).
+		Add("items[0].sku", "errors", "required").
+		Add("email", "errors", "required", M{"field": "Mail"}).
+		AddDefault("errors", "failed")
+
+	want := map[string][]string{
+		"username":     {"User name minimum length is 3", "Nickname is required"},
+		"email":        {"Mail is required"},
+		"items[0].sku": {"items[0].sku is required"},
+		"_summary":     {"Signup failed"},
+	}
+	if got := e.FieldMessages(tr); !reflect.DeepEqual(got, want) {
+		t.Errorf("FieldMessages() = %v, want %v", got, want)
+	}
+	labeled := e.WithLabels("form.signup.fields")
+	if got := labeled.FieldMessages(tr)["username"][0]; got != "Login minimum length is 3" {
+		t.Errorf("FieldMessages() with labels = %v", got)
+	}
+	if got := TranslateErr(tr, labeled).Message; got != "Signup failed; email: Mail is required; "+
+		"items[0].sku: items[0].sku is required; Login: Login minimum length is 3; Nickname is required" {
+		t.Errorf("TranslateErr() message = %v", got)
+	}
+	if got := e.FieldMessages(tr)["username"][0]; got != "User name minimum length is 3" {
+		t.Errorf("WithLabels() changed source error, FieldMessages() = %v", got)
+	}
+	if _, ok := e.errors["username"][1].values["{field}"]; !ok || len(e.errors["username"][0].values) != 1 {
+		t.Error("FieldMessages() changed error values")
+	}
+}
@@ -0,0 +1,360 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LocaleLoader Provider of single locale dictionaries for lazy loading
+type LocaleLoader interface {
+	// ListLocales Returns available locales
+	ListLocales(ctx context.Context) ([]string, error)
+	// LoadLocale Returns locale dictionary
+	LoadLocale(ctx context.Context, locale string) (*Dictionary, error)
+}
+
+// InitLazy Initialize translator, which loads locale dictionaries on the first Get.
+// Default locale is loaded immediately, so its errors are returned here.
+// When maxLocales > 0, least recently used locales over the limit are unloaded,
+// default locale is never unloaded. Failed loads are retried on Get after a delay,
+// which doubles with each failure up to a minute, Get returns default locale meanwhile.
+func InitLazy(defaultLocale string, loader LocaleLoader, maxLocales int) error {
+	ctx := context.Background()
+	locales, err := loader.ListLocales(ctx)
+	if err != nil {
+		return err
+	}
+
+	lazy := &lazyLoader{
+		loader: loader,
+		max:    maxLocales,
+		now:    time.Now,
+		calls:  map[string]*lazyCall{},
+	}
+	available := make(map[string]struct{}, len(locales))
+	for _, locale := range locales {
+		available[locale] = struct{}{}
+	}
+	lazy.locales.Store(&available)
+	lazy.entries.Store(&map[string]*lazyEntry{})
+	if !lazy.has(defaultLocale) {
+		return errors.New("no dictionary for default language")
+	}
+
+	dict, err := loader.LoadLocale(ctx, defaultLocale)
+	if err != nil {
+		return err
+	}
+	tr, err := newTranslator(defaultLocale, dict)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	snap := &snapshot{
+		defLocale:        defaultLocale,
+		availableLocales: locales,
+		translators:      TranslatorCollection{defaultLocale: tr},
+		lazy:             lazy,
+	}
+	if err = snap.applyOverlays(load()); err != nil {
+		return err
+	}
+
+	current.Store(snap)
+	return nil
+}
+
+// InitLazyFromDir Initialize translator, which loads "locale.json" files on the first Get.
+// All files of directory are available, when locales are not set.
+func InitLazyFromDir(defaultLocale, translationsPath string, maxLocales int, locales ...string) error {
+	return InitLazy(defaultLocale, &DirSource{Path: translationsPath, Locales: locales}, maxLocales)
+}
+
+// ListLocales Returns configured locales or locales of directory files
+func (s *DirSource) ListLocales(_ context.Context) ([]string, error) {
+	if len(s.Locales) > 0 {
+		return s.Locales, nil
+	}
+	return listDictionaryFiles(s.Path)
+}
+
+// LoadLocale Returns dictionary from "locale.json" file
+func (s *DirSource) LoadLocale(_ context.Context, locale string) (*Dictionary, error) {
+	return loadDictionaryFile(s.Path, locale)
+}
+
+const (
+	// lazyRetryMin Delay of retry after first failed load of locale
+	lazyRetryMin = time.Second
+	// lazyRetryMax Maximum delay of retry after repeated failed loads of locale
+	lazyRetryMax = time.Minute
+)
+
+// lazyLoader Caches lazily loaded translators, concurrent loads of a locale are done once.
+// Cached translators are read without locking, writers replace entries under mu.
+type lazyLoader struct {
+	loader LocaleLoader
+	max    int
+	now    func() time.Time
+
+	// locales Available locales, map is copied on write, see remove
+	locales atomic.Pointer[map[string]struct{}]
+	// entries Loaded translators and failed loads by locale, map is copied on write
+	entries atomic.Pointer[map[string]*lazyEntry]
+	// clock Counter of uses, approximate recency of entries
+	clock atomic.Int64
+
+	mu    sync.Mutex
+	calls map[string]*lazyCall
+}
+
+// lazyEntry Loaded translator or error of failed load
+type lazyEntry struct {
+	tr *Translator
+	// used Value of clock at last use
+	used atomic.Int64
+	// tenants Overlay translators by overlay dictionary, map is copied on write, see GetFor
+	tenants atomic.Pointer[map[*Dictionary]*Translator]
+
+	err      error
+	failures int
+	retryAt  time.Time
+}
+
+// lazyCall Locale load in progress
+type lazyCall struct {
+	wg  sync.WaitGroup
+	tr  *Translator
+	err error
+}
+
+// has Checks locale is available for lazy loading
+func (l *lazyLoader) has(locale string) bool {
+	if l == nil {
+		return false
+	}
+	_, ok := (*l.locales.Load())[locale]
+	return ok
+}
+
+// invalidate Removes cached translator of locale, e.g. translator changed by Set is loaded
+func (l *lazyLoader) invalidate(locale string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entries := l.copyEntries()
+	delete(entries, locale)
+	l.entries.Store(&entries)
+}
+
+// remove Makes locale unavailable for lazy loading, see RemoveLocale
+func (l *lazyLoader) remove(locale string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	prev := *l.locales.Load()
+	locales := make(map[string]struct{}, len(prev))
+	for available := range prev {
+		if available != locale {
+			locales[available] = struct{}{}
+		}
+	}
+	l.locales.Store(&locales)
+	entries := l.copyEntries()
+	delete(entries, locale)
+	l.entries.Store(&entries)
+}
+
+// entry Returns cached entry of locale
+func (l *lazyLoader) entry(locale string) (*lazyEntry, bool) {
+	e, ok := (*l.entries.Load())[locale]
+	return e, ok
+}
+
+// cached Reports whether entry is loaded translator or failed load before retry
+func (l *lazyLoader) cached(e *lazyEntry) bool {
+	return e.err == nil || l.now().Before(e.retryAt)
+}
+
+// touch Marks entry as the most recently used, entry already marked is not written again
+func (l *lazyLoader) touch(e *lazyEntry) {
+	if e.used.Load() != l.clock.Load() {
+		e.used.Store(l.clock.Add(1))
+	}
+}
+
+// get Returns cached translator or loads it
+func (l *lazyLoader) get(locale string) (*Translator, error) {
+	if !l.has(locale) {
+		return nil, errors.New("locale not available")
+	}
+	if e, ok := l.entry(locale); ok && l.cached(e) {
+		if e.err != nil {
+			return nil, e.err
+		}
+		l.touch(e)
+		return e.tr, nil
+	}
+	return l.load(locale)
+}
+
+// load Loads translator of locale, concurrent callers wait for the same load
+func (l *lazyLoader) load(locale string) (*Translator, error) {
+	l.mu.Lock()
+	// Load may be finished by other caller while waiting for lock
+	if e, ok := l.entry(locale); ok && l.cached(e) {
+		l.mu.Unlock()
+		return e.tr, e.err
+	}
+	if c, ok := l.calls[locale]; ok {
+		l.mu.Unlock()
+		c.wg.Wait()
+		return c.tr, c.err
+	}
+	c := &lazyCall{}
+	c.wg.Add(1)
+	l.calls[locale] = c
+	l.mu.Unlock()
+
+	dict, err := l.loader.LoadLocale(context.Background(), locale)
+	if err == nil {
+		c.tr, c.err = newTranslator(locale, dict)
+	} else {
+		c.err = err
+	}
+
+	l.mu.Lock()
+	delete(l.calls, locale)
+	entries := l.copyEntries()
+	if c.err == nil {
+		e := &lazyEntry{tr: c.tr}
+		e.used.Store(l.clock.Add(1))
+		entries[locale] = e
+		l.evict(entries)
+	} else {
+		failures := 1
+		if prev, ok := entries[locale]; ok && prev.err != nil {
+			failures = prev.failures + 1
+		}
+		entries[locale] = &lazyEntry{
+			err:      c.err,
+			failures: failures,
+			retryAt:  l.now().Add(retryDelay(failures)),
+		}
+	}
+	l.entries.Store(&entries)
+	l.mu.Unlock()
+	c.wg.Done()
+
+	return c.tr, c.err
+}
+
+// copyEntries Returns copy of entries to be modified and stored, l.mu must be held
+func (l *lazyLoader) copyEntries() map[string]*lazyEntry {
+	prev := *l.entries.Load()
+	entries := make(map[string]*lazyEntry, len(prev)+1)
+	for locale, e := range prev {
+		entries[locale] = e
+	}
+	return entries
+}
+
+// evict Removes least recently used translators over the limit with their overlay translators
+func (l *lazyLoader) evict(entries map[string]*lazyEntry) {
+	if l.max <= 0 {
+		return
+	}
+	for {
+		loaded, oldest := 0, ""
+		for locale, e := range entries {
+			if e.err != nil {
+				continue
+			}
+			loaded++
+			if oldest == "" || e.used.Load() < entries[oldest].used.Load() {
+				oldest = locale
+			}
+		}
+		if loaded <= l.max {
+			return
+		}
+		delete(entries, oldest)
+	}
+}
+
+// retryDelay Returns delay of retry after failures, delay doubles with each failure
+func retryDelay(failures int) time.Duration {
+	delay := lazyRetryMin
+	for i := 1; i < failures && delay < lazyRetryMax; i++ {
+		delay *= 2
+	}
+	return min(delay, lazyRetryMax)
+}
+
+// getTenant Returns overlay translator of dict on top of cached or loaded translator of locale
+func (l *lazyLoader) getTenant(locale string, dict *Dictionary) (*Translator, error) {
+	base, err := l.get(locale)
+	if err != nil {
+		return nil, err
+	}
+	e, ok := l.entry(locale)
+	if !ok || e.tr != base {
+		// Translator unloaded meanwhile, overlay is not cached
+		return newOverlayTranslator(base, dict)
+	}
+	if tenants := e.tenants.Load(); tenants != nil {
+		if tr, ok := (*tenants)[dict]; ok {
+			return tr, nil
+		}
+	}
+
+	tr, err := newOverlayTranslator(base, dict)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	tenants := map[*Dictionary]*Translator{dict: tr}
+	if prev := e.tenants.Load(); prev != nil {
+		for prevDict, prevTr := range *prev {
+			tenants[prevDict] = prevTr
+		}
+	}
+	e.tenants.Store(&tenants)
+	return tr, nil
+}
+
+// forget Removes overlay translators of replaced or removed overlay
+func (l *lazyLoader) forget(overlay *DictionaryCollection) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for locale, e := range *l.entries.Load() {
+		prev := e.tenants.Load()
+		dict, ok := (*overlay)[locale]
+		if prev == nil || !ok {
+			continue
+		}
+		if _, ok = (*prev)[dict]; !ok {
+			continue
+		}
+		tenants := make(map[*Dictionary]*Translator, len(*prev))
+		for prevDict, tr := range *prev {
+			if prevDict != dict {
+				tenants[prevDict] = tr
+			}
+		}
+		e.tenants.Store(&tenants)
+	}
+}
@@ -0,0 +1,302 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingLoader Counts dictionary loads per locale
+type countingLoader struct {
+	mu    sync.Mutex
+	loads map[string]int
+	total atomic.Int32
+}
+
+func (l *countingLoader) ListLocales(context.Context) ([]string, error) {
+	return []string{"en", "cz", "de", "fr", "broken"}, nil
+}
+
+func (l *countingLoader) LoadLocale(_ context.Context, locale string) (*Dictionary, error) {
+	l.total.Add(1)
+	l.mu.Lock()
+	l.loads[locale]++
+	l.mu.Unlock()
+
+	if locale == "broken" {
+		return nil, errors.New("broken dictionary")
+	}
+	// Slow load makes concurrent callers wait for the same load
+	time.Sleep(10 * time.Millisecond)
+	return &Dictionary{"form": {"locale": "Locale " + locale}}, nil
+}
+
+func (l *countingLoader) count(locale string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.loads[locale]
+}
+
+func TestInitLazy(t *testing.T) {
+	loader := &countingLoader{loads: map[string]int{}}
+	if err := InitLazy("en", loader, 2); err != nil {
+		t.Fatal(err)
+	}
+	if got := loader.total.Load(); got != 1 {
+		t.Errorf("InitLazy() loads = %v, want only default locale", got)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := Get("cz").T("form", "locale"); got != "Locale cz" {
+				t.Errorf("Get().T() = %v", got)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := loader.count("cz"); got != 1 {
+		t.Errorf("concurrent Get() loads = %v, want 1", got)
+	}
+
+	if got := Get("broken").T("form", "locale"); got != "Locale en" {
+		t.Errorf("Get().T() for broken locale = %v, want default locale", got)
+	}
+	if got := Get("unknown").T("form", "locale"); got != "Locale en" {
+		t.Errorf("Get().T() for unknown locale = %v, want default locale", got)
+	}
+
+	// cz is evicted as least recently used, default locale is not counted
+	Get("de")
+	Get("fr")
+	Get("cz")
+	if got := loader.count("cz"); got != 2 {
+		t.Errorf("Get() loads after eviction = %v, want 2", got)
+	}
+	Get("en")
+	if got := loader.count("en"); got != 1 {
+		t.Errorf("default locale loads = %v, want 1", got)
+	}
+	if got := len(AvailableLocales()); got != 5 {
+		t.Errorf("AvailableLocales() = %v locales, want 5", got)
+	}
+}
+
+func TestInitLazy_Retry(t *testing.T) {
+	loader := &countingLoader{loads: map[string]int{}}
+	if err := InitLazy("en", loader, 0); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, time.March, 7, 10, 0, 0, 0, time.UTC)
+	load().lazy.now = func() time.Time { return now }
+
+	tests := []struct {
+		name    string
+		advance time.Duration
+		want    int
+	}{
+		{"first load", 0, 1},
+		{"failed load is cached", 500 * time.Millisecond, 1},
+		{"retry after 1s", 500 * time.Millisecond, 2},
+		{"delay is doubled", 1500 * time.Millisecond, 2},
+		{"retry after 2s", 500 * time.Millisecond, 3},
+		{"retry after 4s", 4 * time.Second, 4},
+		{"retry after 8s", 8 * time.Second, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = now.Add(tt.advance)
+			if got := Get("broken").T("form", "locale"); got != "Locale en" {
+				t.Errorf("Get().T() = %v, want default locale", got)
+			}
+			if got := loader.count("broken"); got != tt.want {
+				t.Errorf("loads = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitLazy_Update(t *testing.T) {
+	loader := &countingLoader{loads: map[string]int{}}
+	if err := InitLazy("en", loader, 1); err != nil {
+		t.Fatal(err)
+	}
+	defer RemoveOverlay("acme")
+	if err := SetOverlay("acme", &DictionaryCollection{"cz": {"form": {"title": "Acme"}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Changed lazily loaded locale is kept loaded
+	if err := Set("cz", "form", "locale", "Changed cz"); err != nil {
+		t.Fatal(err)
+	}
+	Get("de")
+	Get("fr")
+	if got := Get("cz").T("form", "locale"); got != "Changed cz" {
+		t.Errorf("Get().T() after Set() = %v, want Changed cz", got)
+	}
+	if got := GetFor("acme", "cz").T("form", "locale"); got != "Changed cz" {
+		t.Errorf("GetFor().T() after Set() = %v, want Changed cz", got)
+	}
+	if got := GetFor("acme", "cz").T("form", "title"); got != "Acme" {
+		t.Errorf("GetFor().T() overlay after Set() = %v, want Acme", got)
+	}
+	if err := DeleteKey("cz", "form", "locale"); err != nil {
+		t.Fatal(err)
+	}
+	if got := Get("cz").T("form", "locale"); got != "form.locale" {
+		t.Errorf("Get().T() after DeleteKey() = %v, want form.locale", got)
+	}
+	if got := loader.count("cz"); got != 1 {
+		t.Errorf("loads of changed locale = %v, want 1", got)
+	}
+
+	// Removed locale is not loaded again
+	for _, locale := range []string{"cz", "de", "fr"} {
+		if err := RemoveLocale(locale); err != nil {
+			t.Fatalf("RemoveLocale(%s) error = %v", locale, err)
+		}
+		if got := Get(locale).T("form", "locale"); got != "Locale en" {
+			t.Errorf("Get(%s).T() after RemoveLocale() = %v, want default locale", locale, got)
+		}
+	}
+	if got := loader.count("de") + loader.count("fr"); got != 2 {
+		t.Errorf("loads of removed locales = %v, want 2", got)
+	}
+	if got := len(AvailableLocales()); got != 2 {
+		t.Errorf("AvailableLocales() = %v, want en and broken", AvailableLocales())
+	}
+
+	if err := AddLocale("de", &Dictionary{"form": {"locale": "Added de"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := Get("de").T("form", "locale"); got != "Added de" {
+		t.Errorf("Get().T() after AddLocale() = %v, want Added de", got)
+	}
+	if err := AddLocale("broken", &Dictionary{}); err == nil {
+		t.Error("AddLocale() of lazily available locale error = nil, want error")
+	}
+	if err := Set("broken", "form", "locale", "Broken"); err == nil {
+		t.Error("Set() of locale failing to load error = nil, want error")
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{1, time.Second},
+		{3, 4 * time.Second},
+		{7, time.Minute},
+		{100, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := retryDelay(tt.failures); got != tt.want {
+			t.Errorf("retryDelay(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestInitLazy_Overlay(t *testing.T) {
+	loader := &countingLoader{loads: map[string]int{}}
+	if err := InitLazy("en", loader, 1); err != nil {
+		t.Fatal(err)
+	}
+	defer RemoveOverlay("acme")
+
+	overlay := DictionaryCollection{
+		"en": {"form": {"locale": "Acme en"}},
+		"cz": {"form": {"locale": "Acme cz"}},
+	}
+	if err := SetOverlay("acme", &overlay); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetOverlay("acme", &DictionaryCollection{"ru": {}}); err == nil {
+		t.Error("SetOverlay() of unknown locale error = nil, want error")
+	}
+
+	tests := []struct {
+		tenant string
+		locale string
+		want   string
+	}{
+		{"acme", "cz", "Acme cz"},
+		{"other", "cz", "Locale cz"},
+		{"acme", "de", "Locale de"},
+		// cz is unloaded by de, its overlay is built again
+		{"acme", "cz", "Acme cz"},
+		{"acme", "en", "Acme en"},
+	}
+	for _, tt := range tests {
+		if got := GetFor(tt.tenant, tt.locale).T("form", "locale"); got != tt.want {
+			t.Errorf("GetFor(%s, %s).T() = %v, want %v", tt.tenant, tt.locale, got, tt.want)
+		}
+	}
+	if GetFor("acme", "cz") != GetFor("acme", "cz") {
+		t.Error("GetFor() overlay translator of loaded locale is not cached")
+	}
+
+	RemoveOverlay("acme")
+	if got := GetFor("acme", "cz").T("form", "locale"); got != "Locale cz" {
+		t.Errorf("GetFor().T() after RemoveOverlay() = %v, want Locale cz", got)
+	}
+}
+
+func TestInitLazyFromDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"en.json": `{"errors": {"unknown": "Unknown error"}}`,
+		"cz.json": `{"errors": {"unknown": "Neznámá chyba"}}`,
+		"de.json": `{"errors": `,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := InitLazyFromDir("en", dir, 0); err != nil {
+		t.Fatal(err)
+	}
+	if got := Get("cz").T("errors", "unknown"); got != "Neznámá chyba" {
+		t.Errorf("Get().T() = %v", got)
+	}
+	if got := Get("de").T("errors", "unknown"); got != "Unknown error" {
+		t.Errorf("Get().T() for invalid file = %v, want default locale", got)
+	}
+	if got := GetFor("acme", "cz").T("errors", "unknown"); got != "Neznámá chyba" {
+		t.Errorf("GetFor().T() = %v", got)
+	}
+
+	if err := InitLazyFromDir("de", dir, 0); err == nil {
+		t.Error("InitLazyFromDir() error = nil, want default locale decode error")
+	}
+	if err := InitLazyFromDir("ru", dir, 0); err == nil {
+		t.Error("InitLazyFromDir() error = nil, want missing default locale")
+	}
+}
+
+func BenchmarkGetT_Lazy_Parallel(b *testing.B) {
+	loader := &countingLoader{loads: map[string]int{}}
+	if err := InitLazy("en", loader, 2); err != nil {
+		b.Fatal(err)
+	}
+	Get("cz")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = Get("cz").T("form", "locale")
+		}
+	})
+}
@@ -0,0 +1,339 @@
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// token Part of compiled message, literal text, placeholder name, e.g. "{count}",
+// or reference to another entry, e.g. "{@support.email_link}"
+type token struct {
+	text        string
+	placeholder bool
+	reference   bool
+}
+
+// message Dictionary entry compiled at load time
+type message struct {
+	// source is the dictionary string, raw has references resolved
+	source string
+	raw    string
+	// tokens is nil, when raw has no placeholders
+	tokens []token
+	// refs are "section.key" entries referenced by source
+	refs []string
+}
+
+// catalog "section" => "key" => compiled message
+type catalog map[string]map[string]*message
+
+// bufPool Render buffers. Plain byte slices are pooled instead of strings.Builder,
+// because Builder.String shares its buffer and cannot be reused after that
+var bufPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, 128)
+		return &b
+	},
+}
+
+// compileDictionary Returns compiled catalog for locale dictionary,
+// references between entries are resolved, cycles are reported as error
+func compileDictionary(dict *Dictionary) (catalog, error) {
+	c := compileEntries(dict)
+	if err := c.resolve(c.lookup); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// compileEntries Returns catalog with unresolved references
+func compileEntries(dict *Dictionary) catalog {
+	c := catalog{}
+	if dict == nil {
+		return c
+	}
+	for section, entry := range *dict {
+		if entry == nil {
+			continue
+		}
+		messages := make(map[string]*message, len(*entry))
+		for key, raw := range *entry {
+			messages[key] = compileMessage(raw)
+		}
+		c[section] = messages
+	}
+	return c
+}
+
+// compileMessage Splits raw string to literal, "{placeholder}" and "{@section.key}" tokens
+func compileMessage(raw string) *message {
+	m := &message{source: raw, raw: raw}
+	start := 0
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '{' {
+			continue
+		}
+		end := placeholderEnd(raw, i)
+		if end < 0 {
+			continue
+		}
+		if i > start {
+			m.tokens = append(m.tokens, token{text: raw[start:i]})
+		}
+		if ref := raw[i+1 : end]; ref[0] == '@' && validReference(ref[1:]) {
+			m.tokens = append(m.tokens, token{text: ref[1:], reference: true})
+			m.refs = append(m.refs, ref[1:])
+		} else {
+			m.tokens = append(m.tokens, token{text: raw[i : end+1], placeholder: true})
+		}
+		start = end + 1
+		i = end
+	}
+	if m.tokens != nil && start < len(raw) {
+		m.tokens = append(m.tokens, token{text: raw[start:]})
+	}
+	return m
+}
+
+// validReference Checks "section.key" reference has both parts
+func validReference(ref string) bool {
+	dot := strings.LastIndexByte(ref, '.')
+	return dot > 0 && dot < len(ref)-1
+}
+
+// splitReference Returns section and key of "section.key" reference,
+// sections may contain dots, so key is the last part
+func splitReference(ref string) (section, key string) {
+	dot := strings.LastIndexByte(ref, '.')
+	return ref[:dot], ref[dot+1:]
+}
+
+// resolve Inlines referenced entries into messages, references are looked up with lookup
+func (c catalog) resolve(lookup func(section, key string) (*message, bool)) error {
+	r := resolver{
+		lookup: lookup,
+		state:  map[*message]uint8{},
+	}
+	for section, messages := range c {
+		for key, m := range messages {
+			if err := r.visit(section+"."+key, m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolveMessage Inlines referenced entries into single message
+func resolveMessage(name string, m *message, lookup func(section, key string) (*message, bool)) error {
+	r := resolver{
+		lookup: lookup,
+		state:  map[*message]uint8{},
+	}
+	return r.visit(name, m)
+}
+
+const (
+	resolving uint8 = iota + 1
+	resolved
+)
+
+// resolver Depth first walk over references with cycle detection
+type resolver struct {
+	lookup func(section, key string) (*message, bool)
+	state  map[*message]uint8
+	path   []string
+}
+
+func (r *resolver) visit(name string, m *message) error {
+	switch r.state[m] {
+	case resolved:
+		return nil
+	case resolving:
+		return fmt.Errorf("cyclic message reference %s -> %s", strings.Join(r.path, " -> "), name)
+	}
+	if !m.hasReferences() {
+		r.state[m] = resolved
+		return nil
+	}
+
+	r.state[m] = resolving
+	r.path = append(r.path, name)
+	tokens := make([]token, 0, len(m.tokens))
+	for _, t := range m.tokens {
+		if !t.reference {
+			tokens = append(tokens, t)
+			continue
+		}
+		target, ok := r.lookup(splitReference(t.text))
+		if !ok {
+			// Unknown reference is rendered like missing entry in T
+			tokens = append(tokens, token{text: t.text})
+			continue
+		}
+		if err := r.visit(t.text, target); err != nil {
+			return err
+		}
+		if target.tokens == nil {
+			tokens = append(tokens, token{text: target.raw})
+		} else {
+			tokens = append(tokens, target.tokens...)
+		}
+	}
+	r.path = r.path[:len(r.path)-1]
+	r.state[m] = resolved
+
+	m.setTokens(tokens)
+	return nil
+}
+
+// hasReferences Checks message has unresolved references
+func (m *message) hasReferences() bool {
+	for _, t := range m.tokens {
+		if t.reference {
+			return true
+		}
+	}
+	return false
+}
+
+// setTokens Merges adjacent literals and updates raw string
+func (m *message) setTokens(tokens []token) {
+	merged := tokens[:0]
+	placeholders := false
+	for _, t := range tokens {
+		if t.text == "" {
+			continue
+		}
+		placeholders = placeholders || t.placeholder
+		if n := len(merged); n > 0 && !t.placeholder && !merged[n-1].placeholder {
+			merged[n-1].text += t.text
+			continue
+		}
+		merged = append(merged, t)
+	}
+
+	var raw strings.Builder
+	for _, t := range merged {
+		raw.WriteString(t.text)
+	}
+	m.raw = raw.String()
+	if placeholders {
+		m.tokens = merged
+	} else {
+		m.tokens = nil
+	}
+}
+
+// placeholderEnd Returns index of closing brace for placeholder started at i, or -1
+func placeholderEnd(raw string, i int) int {
+	for j := i + 1; j < len(raw); j++ {
+		switch raw[j] {
+		case '}':
+			if j == i+1 {
+				return -1
+			}
+			return j
+		case '{':
+			return -1
+		}
+	}
+	return -1
+}
+
+// lookup Returns compiled message for section and key
+func (c catalog) lookup(section, key string) (*message, bool) {
+	m, ok := c[section][key]
+	return m, ok
+}
+
+// add Sets compiled message for section and key
+func (c catalog) add(section, key string, m *message) {
+	if _, ok := c[section]; !ok {
+		c[section] = map[string]*message{}
+	}
+	c[section][key] = m
+}
+
+// appendTo Renders message with values to dst, typed arguments are formatted for tr locale
+func (m *message) appendTo(dst []byte, tr *Translator, values M) []byte {
+	if m.tokens == nil {
+		return append(dst, m.raw...)
+	}
+	for _, t := range m.tokens {
+		if !t.placeholder {
+			dst = append(dst, t.text...)
+			continue
+		}
+		value, ok := values[t.text]
+		if !ok {
+			// Placeholder may be passed without braces, e.g. M{"count": 5}
+			value, ok = values[t.text[1:len(t.text)-1]]
+		}
+		if !ok {
+			dst = append(dst, t.text...)
+			continue
+		}
+		dst = appendValue(dst, tr, value)
+	}
+	return dst
+}
+
+// render Returns message formatted with values
+func (m *message) render(tr *Translator, values M) string {
+	if m.tokens == nil || len(values) == 0 {
+		return m.raw
+	}
+	buf := bufPool.Get().(*[]byte)
+	b := m.appendTo((*buf)[:0], tr, values)
+	str := string(b)
+	*buf = b
+	bufPool.Put(buf)
+	return str
+}
+
+// appendValue Formats placeholder value without reflection
+func appendValue(dst []byte, tr *Translator, value interface{}) []byte {
+	switch v := value.(type) {
+	case Arg:
+		return v.AppendArg(dst, tr)
+	case string:
+		return append(dst, v...)
+	case int:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int8:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int16:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int32:
+		return strconv.AppendInt(dst, int64(v), 10)
+	case int64:
+		return strconv.AppendInt(dst, v, 10)
+	case uint:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint8:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint16:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint32:
+		return strconv.AppendUint(dst, uint64(v), 10)
+	case uint64:
+		return strconv.AppendUint(dst, v, 10)
+	case float32:
+		return strconv.AppendFloat(dst, float64(v), 'f', 6, 32)
+	case float64:
+		return strconv.AppendFloat(dst, v, 'f', 6, 64)
+	case bool:
+		return strconv.AppendBool(dst, v)
+	case error:
+		return append(dst, v.Error()...)
+	case fmt.Stringer:
+		return append(dst, v.String()...)
+	case nil:
+		return dst
+	default:
+		return fmt.Append(dst, v)
+	}
+}
@@ -0,0 +1,285 @@
+package i18n
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testTranslator(tb testing.TB, locale string, dict *Dictionary) *Translator {
+	tb.Helper()
+	tr, err := newTranslator(locale, dict)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return tr
+}
+
+func TestCompileMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []token
+	}{
+		{
+			name: "no placeholders",
+			raw:  "Field too short",
+			want: nil,
+		},
+		{
+			name: "single placeholder",
+			raw:  "Hello, {name}",
+			want: []token{{text: "Hello, "}, {text: "{name}", placeholder: true}},
+		},
+		{
+			name: "several placeholders",
+			raw:  "{field} minimum length is {min}.",
+			want: []token{
+				{text: "{field}", placeholder: true},
+				{text: " minimum length is "},
+				{text: "{min}", placeholder: true},
+				{text: "."},
+			},
+		},
+		{
+			name: "reference",
+			raw:  "Contact {@support.email_link} for {topic}",
+			want: []token{
+				{text: "Contact "},
+				{text: "support.email_link", reference: true},
+				{text: " for "},
+				{text: "{topic}", placeholder: true},
+			},
+		},
+		{
+			name: "unbalanced braces",
+			raw:  "{} and {{name} and {open",
+			want: []token{
+				{text: "{} and {"},
+				{text: "{name}", placeholder: true},
+				{text: " and {open"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compileMessage(tt.raw)
+			if got.raw != tt.raw {
+				t.Errorf("compileMessage().raw = %v, want %v", got.raw, tt.raw)
+			}
+			if !reflect.DeepEqual(got.tokens, tt.want) {
+				t.Errorf("compileMessage().tokens = %v, want %v", got.tokens, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileDictionary_References(t *testing.T) {
+	tr := testTranslator(t, "en", &Dictionary{
+		"product": {
+			"name": "Acme Cloud",
+			"full": "{@product.name} {edition}",
+		},
+		"support": {
+			"email_link": "support@acme.test",
+			"contact":    "Contact {@support.email_link} for help with {@product.full}",
+			"missing":    "See {@support.unknown}",
+			"invalid":    "Hello {@name}",
+		},
+		"errors.connections": {
+			"limit": "{@product.name}: limit is {count}",
+		},
+	})
+
+	tests := []struct {
+		name    string
+		section string
+		key     string
+		values  M
+		want    string
+	}{
+		{
+			name:    "T resolves references",
+			section: "support",
+			key:     "contact",
+			want:    "Contact support@acme.test for help with Acme Cloud {edition}",
+		},
+		{
+			name:    "Tf formats referenced placeholders",
+			section: "support",
+			key:     "contact",
+			values:  M{"{edition}": "Pro"},
+			want:    "Contact support@acme.test for help with Acme Cloud Pro",
+		},
+		{
+			name:    "dotted section",
+			section: "errors.connections",
+			key:     "limit",
+			values:  M{"{count}": 5},
+			want:    "Acme Cloud: limit is 5",
+		},
+		{
+			name:    "missing reference",
+			section: "support",
+			key:     "missing",
+			want:    "See support.unknown",
+		},
+		{
+			name:    "reference without key is placeholder",
+			section: "support",
+			key:     "invalid",
+			values:  M{"{@name}": "John"},
+			want:    "Hello John",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tr.Tf(tt.section, tt.key, tt.values); got != tt.want {
+				t.Errorf("Translator.Tf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileDictionary_Cycle(t *testing.T) {
+	dict := &Dictionary{
+		"a": {
+			"one": "{@b.two}",
+		},
+		"b": {
+			"two":   "x {@b.three}",
+			"three": "y {@a.one}",
+		},
+	}
+	if _, err := compileDictionary(dict); err == nil || !strings.Contains(err.Error(), "cyclic message reference") {
+		t.Errorf("compileDictionary() error = %v, want cyclic reference", err)
+	}
+
+	collection := DictionaryCollection{testDefaultLocale: dict}
+	if err := Init(testDefaultLocale, &collection); err == nil {
+		t.Error("Init() error = nil, want cyclic reference")
+	}
+}
+
+func TestTranslator_Tf(t *testing.T) {
+	tr := testTranslator(t, "en", &Dictionary{
+		"form": {
+			"limit":  "Connections limit is {count}",
+			"title":  "Hello, {name}",
+			"length": "{field} minimum length is {min}",
+			"ratio":  "Ratio {ratio}, enabled {enabled}",
+			"error":  "Failed: {err}",
+		},
+	})
+
+	tests := []struct {
+		name    string
+		section string
+		key     string
+		values  M
+		want    string
+	}{
+		{
+			name:    "integer",
+			section: "form",
+			key:     "limit",
+			values:  M{"{count}": 50},
+			want:    "Connections limit is 50",
+		},
+		{
+			name:    "string without braces",
+			section: "form",
+			key:     "title",
+			values:  M{"name": "John"},
+			want:    "Hello, John",
+		},
+		{
+			name:    "missing value",
+			section: "form",
+			key:     "length",
+			values:  M{"{min}": uint8(3)},
+			want:    "{field} minimum length is 3",
+		},
+		{
+			name:    "float and bool",
+			section: "form",
+			key:     "ratio",
+			values:  M{"{ratio}": 0.5, "{enabled}": true},
+			want:    "Ratio 0.500000, enabled true",
+		},
+		{
+			name:    "error value",
+			section: "form",
+			key:     "error",
+			values:  M{"{err}": errors.New("timeout")},
+			want:    "Failed: timeout",
+		},
+		{
+			name:    "missing section",
+			section: "unknown",
+			key:     "key",
+			values:  M{"{count}": 1},
+			want:    "unknown.key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tr.Tf(tt.section, tt.key, tt.values); got != tt.want {
+				t.Errorf("Translator.Tf() = %v, want %v", got, tt.want)
+			}
+			if got := string(tr.AppendTf(nil, tt.section, tt.key, tt.values)); got != tt.want {
+				t.Errorf("Translator.AppendTf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslator_AppendTf_Allocs(t *testing.T) {
+	tr := testTranslator(t, "en", &Dictionary{
+		"errors.connections": {
+			"connections_limit": "Connections limit is {count}",
+		},
+	})
+	values := M{"{count}": 50}
+	dst := make([]byte, 0, 64)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = tr.AppendTf(dst[:0], "errors.connections", "connections_limit", values)
+	})
+	if allocs != 0 {
+		t.Errorf("Translator.AppendTf() allocs = %v, want 0", allocs)
+	}
+}
+
+func BenchmarkTranslator_Tf(b *testing.B) {
+	tr := testTranslator(b, "en", &Dictionary{
+		"form": {
+			"length": "{field} minimum length is {min}",
+		},
+	})
+	values := M{"{field}": "Username", "{min}": 3}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = tr.Tf("form", "length", values)
+	}
+}
+
+func BenchmarkTranslator_AppendTf(b *testing.B) {
+	tr := testTranslator(b, "en", &Dictionary{
+		"form": {
+			"length": "{field} minimum length is {min}",
+		},
+	})
+	values := M{"{field}": "Username", "{min}": 3}
+	dst := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst = tr.AppendTf(dst[:0], "form", "length", values)
+	}
+}
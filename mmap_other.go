@@ -0,0 +1,13 @@
+//go:build !unix
+
+package i18n
+
+import "os"
+
+// mapFile Returns file content, memory mapping is not supported on this platform
+func mapFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+// unmapFile Does nothing, file content is released by garbage collector
+func unmapFile([]byte) {}
@@ -0,0 +1,34 @@
+//go:build unix
+
+package i18n
+
+import (
+	"os"
+	"syscall"
+)
+
+// mapFile Returns read only memory mapping of file.
+// Mapping of loaded file is never unmapped, strings returned by translators point into it.
+func mapFile(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() == 0 {
+		return nil, nil
+	}
+	return syscall.Mmap(int(file.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+}
+
+// unmapFile Releases mapping of file, which is not referenced
+func unmapFile(data []byte) {
+	if data != nil {
+		_ = syscall.Munmap(data)
+	}
+}
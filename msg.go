@@ -0,0 +1,138 @@
+package i18n
+
+import "encoding/json"
+
+// Message Translatable message reference, section, key and values.
+// Message is translated when used, so it can be stored in structs, configs
+// or events before locale of recipient is known:
+//
+//	event.Title = i18n.Msg("notifications", "order_shipped", i18n.M{"{id}": order.ID})
+//	text := event.Title.Translate(i18n.Get(user.Locale))
+type Message struct {
+	section string
+	key     string
+	values  M
+	// locale of JSON encoding and String, default locale when empty
+	locale string
+}
+
+// Msg Returns message, also used as nested message placeholder value translated with the same translator
+func Msg(section string, key string, values ...M) Message {
+	if len(values) == 0 {
+		return Message{section: section, key: key}
+	}
+	return Message{section: section, key: key, values: values[0]}
+}
+
+func (m Message) AppendArg(dst []byte, tr *Translator) []byte {
+	return tr.AppendTf(dst, m.section, m.key, m.values)
+}
+
+// Section Returns translatorsCollection section
+func (m Message) Section() string {
+	return m.section
+}
+
+// Key Returns translatorsCollection key
+func (m Message) Key() string {
+	return m.key
+}
+
+// Values Returns values for formatted output
+func (m Message) Values() M {
+	return m.values
+}
+
+// Locale Returns locale of JSON encoding and String, empty for default locale
+func (m Message) Locale() string {
+	return m.locale
+}
+
+// WithLocale Returns message copy translated to locale by String and MarshalJSON,
+// translator is resolved at encoding time, so reloaded dictionaries are used
+func (m Message) WithLocale(locale string) Message {
+	m.locale = locale
+	return m
+}
+
+// Translate Returns message translated by tr, nil tr is translator of message locale.
+// Returns "section.key" when translator is not initialized
+func (m Message) Translate(tr *Translator) string {
+	if tr == nil {
+		if tr = getTranslator(m.locale); tr == nil {
+			return Define(m.section, m.key).Error()
+		}
+	}
+	return m.base().translate(tr, "")
+}
+
+// String Returns message translated to message locale or default locale
+func (m Message) String() string {
+	return m.Translate(nil)
+}
+
+// MarshalText Returns message translated to message locale or default locale
+func (m Message) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// MarshalJSON Returns message translated to message locale or default locale as JSON string
+func (m Message) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON Reads translated message, e.g. encoded by MarshalJSON, which is restored as is,
+// or full {"s": section, "k": key, "v": values, "l": locale} form, e.g. stored by Value.
+// JSON null keeps message unchanged
+func (m *Message) UnmarshalJSON(b []byte) error {
+	if string(b) == string(nullJSON) {
+		return nil
+	}
+	var message string
+	if json.Unmarshal(b, &message) == nil {
+		return m.UnmarshalText([]byte(message))
+	}
+	var r errorFull
+	if err := json.Unmarshal(b, &r); err != nil {
+		return err
+	}
+	*m = Message{
+		section: r.Section,
+		key:     r.Key,
+		values:  M(r.Values),
+		locale:  r.Locale,
+	}
+	return nil
+}
+
+// UnmarshalText Reads translated message, e.g. encoded by MarshalText, message is restored as is
+func (m *Message) UnmarshalText(text []byte) error {
+	*m = Message{
+		section: messageErrSection,
+		key:     string(text),
+	}
+	return nil
+}
+
+// Err Returns *I18nError of message
+func (m Message) Err() *I18nError {
+	return &I18nError{BaseError: m.base()}
+}
+
+// base Returns BaseError of message
+func (m Message) base() *BaseError {
+	return &BaseError{
+		section: m.section,
+		key:     m.key,
+		values:  m.values,
+	}
+}
+
+// Message Returns message of error, see Msg
+func (e *BaseError) Message() Message {
+	return Message{
+		section: e.section,
+		key:     e.key,
+		values:  e.values,
+	}
+}
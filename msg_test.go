@@ -0,0 +1,184 @@
+package i18n
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestMessage(t *testing.T) {
+	err := Init("en", &DictionaryCollection{
+		"en": {"notifications": {"shipped": `Order "{id}" shipped`, "title": "Shipping"}},
+		"cz": {"notifications": {"shipped": `Objednávka "{id}" odeslána`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := Msg("notifications", "shipped", M{"{id}": 42})
+
+	tests := []struct {
+		name string
+		got  func() (string, error)
+		want string
+	}{
+		{
+			name: "translate",
+			got:  func() (string, error) { return msg.Translate(Get("cz")), nil },
+			want: `Objednávka "42" odeslána`,
+		},
+		{
+			name: "translate message locale",
+			got:  func() (string, error) { return msg.WithLocale("cz").Translate(nil), nil },
+			want: `Objednávka "42" odeslána`,
+		},
+		{
+			name: "stringer",
+			got:  func() (string, error) { return fmt.Sprint(msg), nil },
+			want: `Order "42" shipped`,
+		},
+		{
+			name: "text",
+			got: func() (string, error) {
+				b, err := encoding.TextMarshaler(msg.WithLocale("cz")).MarshalText()
+				return string(b), err
+			},
+			want: `Objednávka "42" odeslána`,
+		},
+		{
+			name: "json",
+			got: func() (string, error) {
+				b, err := json.Marshal(struct {
+					Title Message `json:"title"`
+					Body  Message `json:"body"`
+				}{
+					Title: Msg("notifications", "title"),
+					Body:  msg.WithLocale("cz"),
+				})
+				return string(b), err
+			},
+			want: `{"title":"Shipping","body":"Objednávka \"42\" odeslána"}`,
+		},
+		{
+			name: "json fallback locale",
+			got: func() (string, error) {
+				b, err := json.Marshal(Msg("notifications", "title").WithLocale("de"))
+				return string(b), err
+			},
+			want: `"Shipping"`,
+		},
+		{
+			name: "error message",
+			got: func() (string, error) {
+				return NewErr("notifications", "shipped", M{"{id}": 7}).Message().String(), nil
+			},
+			want: `Order "7" shipped`,
+		},
+		{
+			name: "restored error message",
+			got: func() (string, error) {
+				e := &I18nError{}
+				err := json.Unmarshal([]byte(`"Odesláno"`), e)
+				return e.Message().String(), err
+			},
+			want: "Odesláno",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.got()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Message = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessage_Err(t *testing.T) {
+	msg := Msg("errors", "min", M{"{min}": 3})
+	e := msg.Err()
+	if e.Section() != "errors" || e.Key() != "min" || e.Values()["{min}"] != 3 {
+		t.Errorf("Err() = %v %v", e, e.Values())
+	}
+	if got := e.Message(); got.Section() != msg.Section() || got.Key() != msg.Key() || got.Values()["{min}"] != 3 {
+		t.Errorf("Message() = %v", got)
+	}
+}
+
+func TestMessage_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want Message
+	}{
+		{
+			name: "translated",
+			data: `"Order \"42\" shipped"`,
+			want: Message{section: messageErrSection, key: `Order "42" shipped`},
+		},
+		{
+			name: "full",
+			data: `{"s":"notifications","k":"shipped","v":{"{id}":{"$int":42},"{by}":{"$msg":{"s":"carriers","k":"dhl"}}},"l":"cz"}`,
+			want: Message{
+				section: "notifications",
+				key:     "shipped",
+				values:  M{"{id}": Int(42), "{by}": Msg("carriers", "dhl")},
+				locale:  "cz",
+			},
+		},
+		{
+			name: "null",
+			data: `null`,
+			want: Msg("other", "message"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Msg("other", "message")
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalJSON() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+
+	if err := json.Unmarshal([]byte(`[1]`), &Message{}); err == nil {
+		t.Error("UnmarshalJSON() error = nil, want error")
+	}
+}
+
+func TestMessage_UnmarshalText(t *testing.T) {
+	err := Init("en", &DictionaryCollection{
+		"en": {"notifications": {"title": "Shipping"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Translated message is restored and encoded again as is
+	var event struct {
+		Title Message `json:"title"`
+	}
+	if err = json.Unmarshal([]byte(`{"title":"Odeslání"}`), &event); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := json.Marshal(event); err != nil || string(b) != `{"title":"Odeslání"}` {
+		t.Errorf("MarshalJSON() = %s, %v", b, err)
+	}
+
+	var msg Message
+	if err = encoding.TextUnmarshaler(&msg).UnmarshalText([]byte("Shipping")); err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.String(); got != "Shipping" {
+		t.Errorf("UnmarshalText() = %s, want Shipping", got)
+	}
+}
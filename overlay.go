@@ -0,0 +1,232 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SetOverlay Layers tenant dictionaries on top of loaded ones.
+// Overlay may contain only overridden entries, other entries are looked up
+// in the base dictionaries, which are shared by all tenants.
+// Base entries referencing overridden ones, e.g. "{@product.name}", use the tenant value.
+// Overlay of lazily loaded locale is applied when locale is loaded, overlay of locale
+// missing after reload or RemoveLocale is kept and applied again when locale is loaded back.
+//
+//	err := i18n.SetOverlay("acme", &i18n.DictionaryCollection{
+//		"en": {
+//			"product": {
+//				"name": "Acme Cloud",
+//			},
+//		},
+//	})
+func SetOverlay(tenant string, overlay *DictionaryCollection) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	prev := load()
+	if prev == nil {
+		return errors.New("translator not initialized")
+	}
+	if tenant == "" {
+		return errors.New("tenant not set")
+	}
+	if overlay == nil {
+		return errors.New("overlay not set")
+	}
+
+	for locale := range *overlay {
+		if _, ok := prev.translators[locale]; !ok && !prev.lazy.has(locale) {
+			return fmt.Errorf("tenant %s: no base dictionary for locale %s", tenant, locale)
+		}
+	}
+
+	snap := prev.clone()
+	overlayCopy := overlay.clone()
+	translators, err := snap.buildTenant(overlayCopy)
+	if err != nil {
+		return fmt.Errorf("tenant %s: %w", tenant, err)
+	}
+	snap.overlays[tenant] = overlayCopy
+	snap.tenants[tenant] = translators
+
+	current.Store(snap)
+	if replaced, ok := prev.overlays[tenant]; ok {
+		snap.lazy.forget(replaced)
+	}
+	return nil
+}
+
+// RemoveOverlay Removes tenant dictionaries, tenant falls back to base ones
+func RemoveOverlay(tenant string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	prev := load()
+	if prev == nil {
+		return
+	}
+	if _, ok := prev.overlays[tenant]; !ok {
+		return
+	}
+
+	snap := prev.clone()
+	delete(snap.overlays, tenant)
+	delete(snap.tenants, tenant)
+
+	current.Store(snap)
+	snap.lazy.forget(prev.overlays[tenant])
+}
+
+// GetFor Returns Translator instance for tenant and locale.
+// Locale falls back to default one as in Get, tenant without overlay gets base translator.
+func GetFor(tenant string, locale string) *Translator {
+	snap := load()
+	if snap == nil {
+		panic("translator not initialized")
+	}
+	if _, ok := snap.translators[locale]; !ok && !snap.lazy.has(locale) {
+		locale = snap.defLocale
+	}
+	if tr, ok := snap.tenants[tenant][locale]; ok {
+		return tr
+	}
+	if overlay, ok := snap.overlays[tenant]; ok && snap.lazy.has(locale) {
+		if dict, ok := (*overlay)[locale]; ok {
+			if tr, err := snap.lazy.getTenant(locale, dict); err == nil {
+				return tr
+			}
+		}
+	}
+	return Get(locale)
+}
+
+// clone Returns snapshot copy to be modified and published
+func (s *snapshot) clone() *snapshot {
+	snap := &snapshot{
+		defLocale:        s.defLocale,
+		availableLocales: s.availableLocales,
+		lazy:             s.lazy,
+		translators:      make(TranslatorCollection, len(s.translators)),
+		overlays:         make(map[string]*DictionaryCollection, len(s.overlays)),
+		tenants:          make(map[string]TranslatorCollection, len(s.tenants)),
+	}
+	for locale, tr := range s.translators {
+		snap.translators[locale] = tr
+	}
+	for tenant, overlay := range s.overlays {
+		snap.overlays[tenant] = overlay
+	}
+	for tenant, translators := range s.tenants {
+		snap.tenants[tenant] = translators
+	}
+	return snap
+}
+
+// applyOverlays Compiles overlays of previous snapshot on top of new translators
+func (s *snapshot) applyOverlays(prev *snapshot) error {
+	s.overlays = map[string]*DictionaryCollection{}
+	s.tenants = map[string]TranslatorCollection{}
+	if prev == nil {
+		return nil
+	}
+	for tenant, overlay := range prev.overlays {
+		translators, err := s.buildTenant(overlay)
+		if err != nil {
+			return fmt.Errorf("tenant %s: %w", tenant, err)
+		}
+		s.overlays[tenant] = overlay
+		s.tenants[tenant] = translators
+	}
+	return nil
+}
+
+// buildTenant Returns overlay translators for loaded locales, locales without base
+// translator are skipped, lazily loaded ones are built by GetFor
+func (s *snapshot) buildTenant(overlay *DictionaryCollection) (TranslatorCollection, error) {
+	translators := TranslatorCollection{}
+	for locale, dict := range *overlay {
+		base, ok := s.translators[locale]
+		if !ok {
+			continue
+		}
+		tr, err := newOverlayTranslator(base, dict)
+		if err != nil {
+			return nil, err
+		}
+		translators[locale] = tr
+	}
+	return translators, nil
+}
+
+// buildTenantLocale Compiles overlays of locale on top of its loaded translator,
+// tenants without overlay of locale are skipped
+func (s *snapshot) buildTenantLocale(locale string) error {
+	base := s.translators[locale]
+	for tenant, overlay := range s.overlays {
+		dict, ok := (*overlay)[locale]
+		if !ok {
+			continue
+		}
+		tr, err := newOverlayTranslator(base, dict)
+		if err != nil {
+			return fmt.Errorf("tenant %s: %w", tenant, err)
+		}
+		translators := s.tenants[tenant].without(locale)
+		translators[locale] = tr
+		s.tenants[tenant] = translators
+	}
+	return nil
+}
+
+// newOverlayTranslator Returns Translator with dict entries taking priority over base ones
+func newOverlayTranslator(base *Translator, dict *Dictionary) (*Translator, error) {
+	c := compileEntries(dict)
+
+	type entry struct {
+		section, key string
+		m            *message
+	}
+	var referencing []entry
+	for section, messages := range base.messages {
+		for key, m := range messages {
+			if len(m.refs) > 0 {
+				referencing = append(referencing, entry{section, key, m})
+			}
+		}
+	}
+	if base.binary != nil {
+		for _, i := range base.binary.referencing() {
+			section, key, value := base.binary.entry(i)
+			referencing = append(referencing, entry{section, key, compileMessage(value)})
+		}
+	}
+
+	// Base entries referencing overridden ones are compiled again for the overlay,
+	// until no more entries depend on overlay
+	for changed := true; changed; {
+		changed = false
+		for _, e := range referencing {
+			if _, ok := c.lookup(e.section, e.key); ok {
+				continue
+			}
+			for _, ref := range e.m.refs {
+				if _, ok := c.lookup(splitReference(ref)); ok {
+					c.add(e.section, e.key, compileMessage(e.m.source))
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	tr := &Translator{
+		locale:   base.locale,
+		format:   base.format,
+		messages: c,
+		base:     base,
+	}
+	if err := c.resolve(tr.lookup); err != nil {
+		return nil, fmt.Errorf("locale %s: %w", base.locale, err)
+	}
+	return tr, nil
+}
@@ -0,0 +1,201 @@
+package i18n
+
+import (
+	"testing"
+)
+
+func initOverlayDict(t *testing.T) {
+	t.Helper()
+	collection := DictionaryCollection{
+		"en": {
+			"product": {
+				"name":    "Go i18n",
+				"tagline": "{@product.name} for everyone",
+			},
+			"support": {
+				"contact": "Contact {@product.tagline} support",
+				"hours":   "Support hours are {hours}",
+			},
+		},
+		"cz": {
+			"product": {
+				"name": "Go i18n",
+			},
+			"support": {
+				"hours": "Podpora je k dispozici {hours}",
+			},
+		},
+	}
+	if err := Init(testDefaultLocale, &collection); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetFor(t *testing.T) {
+	initOverlayDict(t)
+
+	err := SetOverlay("acme", &DictionaryCollection{
+		"en": {
+			"product": {
+				"name": "Acme Cloud",
+			},
+			"support": {
+				"hours": "Call us {hours}",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		tenant string
+		locale string
+		key    string
+		want   string
+	}{
+		{
+			name:   "overridden entry",
+			tenant: "acme",
+			locale: "en",
+			key:    "hours",
+			want:   "Call us {hours}",
+		},
+		{
+			name:   "base entry referencing overridden one",
+			tenant: "acme",
+			locale: "en",
+			key:    "contact",
+			want:   "Contact Acme Cloud for everyone support",
+		},
+		{
+			name:   "locale without overlay",
+			tenant: "acme",
+			locale: "cz",
+			key:    "hours",
+			want:   "Podpora je k dispozici {hours}",
+		},
+		{
+			name:   "unknown locale falls back to default overlay",
+			tenant: "acme",
+			locale: "de",
+			key:    "hours",
+			want:   "Call us {hours}",
+		},
+		{
+			name:   "unknown tenant",
+			tenant: "globex",
+			locale: "en",
+			key:    "contact",
+			want:   "Contact Go i18n for everyone support",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetFor(tt.tenant, tt.locale).T("support", tt.key); got != tt.want {
+				t.Errorf("GetFor().T() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := Get("en").T("support", "contact"); got != "Contact Go i18n for everyone support" {
+		t.Errorf("Get().T() = %v, base dictionary changed by overlay", got)
+	}
+	if GetFor("acme", "en").base != Get("en") {
+		t.Error("GetFor().base is not shared base translator")
+	}
+}
+
+func TestSetOverlay_Reload(t *testing.T) {
+	initOverlayDict(t)
+
+	overlay := DictionaryCollection{
+		"en": {
+			"product": {
+				"name": "Acme Cloud",
+			},
+		},
+	}
+	if err := SetOverlay("acme", &overlay); err != nil {
+		t.Fatal(err)
+	}
+	(*overlay["en"])["product"] = &DictionaryEntry{"name": "Changed"}
+
+	initOverlayDict(t)
+	if got := GetFor("acme", "en").T("product", "tagline"); got != "Acme Cloud for everyone" {
+		t.Errorf("GetFor().T() after reload = %v", got)
+	}
+
+	RemoveOverlay("acme")
+	if got := GetFor("acme", "en").T("product", "tagline"); got != "Go i18n for everyone" {
+		t.Errorf("GetFor().T() after RemoveOverlay() = %v", got)
+	}
+}
+
+func TestSetOverlay_ReloadWithoutLocale(t *testing.T) {
+	initOverlayDict(t)
+	defer RemoveOverlay("acme")
+
+	overlay := DictionaryCollection{
+		"en": {"product": {"name": "Acme Cloud"}},
+		"cz": {"support": {"hours": "Acme podpora {hours}"}},
+	}
+	if err := SetOverlay("acme", &overlay); err != nil {
+		t.Fatal(err)
+	}
+
+	// Overlay of missing locale does not fail reload
+	if err := Init(testDefaultLocale, &DictionaryCollection{
+		"en": {"product": {"name": "Go i18n", "tagline": "{@product.name} for everyone"}},
+	}); err != nil {
+		t.Fatalf("Init() without overlay locale error = %v", err)
+	}
+	if got := GetFor("acme", "en").T("product", "tagline"); got != "Acme Cloud for everyone" {
+		t.Errorf("GetFor().T() = %v, want Acme Cloud for everyone", got)
+	}
+
+	// Overlay is applied again, when locale is loaded back
+	initOverlayDict(t)
+	if got := GetFor("acme", "cz").Tf("support", "hours", M{"{hours}": "9-17"}); got != "Acme podpora 9-17" {
+		t.Errorf("GetFor().Tf() after locale reload = %v, want Acme podpora 9-17", got)
+	}
+}
+
+func TestSetOverlay_Errors(t *testing.T) {
+	initOverlayDict(t)
+
+	tests := []struct {
+		name    string
+		tenant  string
+		overlay *DictionaryCollection
+	}{
+		{
+			name:    "empty tenant",
+			overlay: &DictionaryCollection{},
+		},
+		{
+			name:   "unknown locale",
+			tenant: "acme",
+			overlay: &DictionaryCollection{
+				"de": {"product": {"name": "Acme"}},
+			},
+		},
+		{
+			name:   "cyclic reference",
+			tenant: "acme",
+			overlay: &DictionaryCollection{
+				"en": {"product": {"name": "{@product.tagline}"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetOverlay(tt.tenant, tt.overlay); err == nil {
+				t.Error("SetOverlay() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package i18n
+
+//go:generate protoc --go_out=. --go_opt=module=github.com/censync/go-i18n i18n.proto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/censync/go-i18n/i18npb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ToProto Returns protobuf message of error
+func (e *I18nError) ToProto() *i18npb.I18NError {
+	if e == nil {
+		return nil
+	}
+	m := &i18npb.I18NError{
+		Code: int32(e.code),
+	}
+	if e.BaseError != nil {
+		m.Section = e.section
+		m.Key = e.key
+		m.Values = valuesToProto(e.values)
+	}
+	if e.locale != nil {
+		m.Locale = *e.locale
+	}
+	return m
+}
+
+// ErrFromProto Creates *I18nError object from protobuf message
+func ErrFromProto(m *i18npb.I18NError) *I18nError {
+	if m == nil {
+		return nil
+	}
+	e := NewErrWithCode(int(m.GetCode()), m.GetSection(), m.GetKey())
+	e.values = valuesFromProto(m.GetValues())
+	if m.GetLocale() != "" {
+		e.SetLocale(m.GetLocale())
+	}
+	return e
+}
+
+// ToProto Returns protobuf message of multiple error
+func (e *I18nMultipleError) ToProto() *i18npb.I18NMultipleError {
+	if e == nil {
+		return nil
+	}
+	m := &i18npb.I18NMultipleError{
+		Code:        int32(e.code),
+		Errors:      make(map[string]*i18npb.BaseError, len(e.errors)),
+		FieldErrors: make(map[string]*i18npb.BaseErrors, len(e.errors)),
+	}
+	if e.locale != nil {
+		m.Locale = *e.locale
+	}
+	for field, fieldErrs := range e.errors {
+		list := &i18npb.BaseErrors{}
+		for _, fieldErr := range fieldErrs {
+			list.Errors = append(list.Errors, fieldErr.toProto())
+		}
+		if len(list.Errors) > 0 {
+			m.Errors[field] = list.Errors[0]
+		}
+		m.FieldErrors[field] = list
+	}
+	return m
+}
+
+// MultipleErrFromProto Creates *I18nMultipleError object from protobuf message,
+// messages without field_errors are read from errors
+func MultipleErrFromProto(m *i18npb.I18NMultipleError) *I18nMultipleError {
+	if m == nil {
+		return nil
+	}
+	e := NewMultipleEmptyErr().WithCode(int(m.GetCode()))
+	if m.GetLocale() != "" {
+		e = e.WithLocale(m.GetLocale())
+	}
+	if len(m.GetFieldErrors()) > 0 {
+		for field, list := range m.GetFieldErrors() {
+			for _, fieldErr := range list.GetErrors() {
+				e.add(field, baseErrFromProto(fieldErr))
+			}
+		}
+		return e
+	}
+	for field, fieldErr := range m.GetErrors() {
+		e.add(field, baseErrFromProto(fieldErr))
+	}
+	return e
+}
+
+func (e *BaseError) toProto() *i18npb.BaseError {
+	return &i18npb.BaseError{
+		Section: e.section,
+		Key:     e.key,
+		Values:  valuesToProto(e.values),
+	}
+}
+
+func baseErrFromProto(m *i18npb.BaseError) *BaseError {
+	return &BaseError{
+		section: m.GetSection(),
+		key:     m.GetKey(),
+		values:  valuesFromProto(m.GetValues()),
+	}
+}
+
+// valuesToProto Returns typed protobuf values, unsupported types are formatted as strings
+func valuesToProto(values map[string]interface{}) map[string]*i18npb.Value {
+	if len(values) == 0 {
+		return nil
+	}
+	m := make(map[string]*i18npb.Value, len(values))
+	for name, value := range values {
+		if v := valueToProto(value); v != nil {
+			m[name] = v
+		}
+	}
+	return m
+}
+
+func valueToProto(value interface{}) *i18npb.Value {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return &i18npb.Value{Kind: &i18npb.Value_StringValue{StringValue: v}}
+	case int:
+		return &i18npb.Value{Kind: &i18npb.Value_IntValue{IntValue: int64(v)}}
+	case int8:
+		return &i18npb.Value{Kind: &i18npb.Value_IntValue{IntValue: int64(v)}}
+	case int16:
+		return &i18npb.Value{Kind: &i18npb.Value_IntValue{IntValue: int64(v)}}
+	case int32:
+		return &i18npb.Value{Kind: &i18npb.Value_IntValue{IntValue: int64(v)}}
+	case int64:
+		return &i18npb.Value{Kind: &i18npb.Value_IntValue{IntValue: v}}
+	case uint:
+		return &i18npb.Value{Kind: &i18npb.Value_UintValue{UintValue: uint64(v)}}
+	case uint8:
+		return &i18npb.Value{Kind: &i18npb.Value_UintValue{UintValue: uint64(v)}}
+	case uint16:
+		return &i18npb.Value{Kind: &i18npb.Value_UintValue{UintValue: uint64(v)}}
+	case uint32:
+		return &i18npb.Value{Kind: &i18npb.Value_UintValue{UintValue: uint64(v)}}
+	case uint64:
+		return &i18npb.Value{Kind: &i18npb.Value_UintValue{UintValue: v}}
+	case float32:
+		return &i18npb.Value{Kind: &i18npb.Value_DoubleValue{DoubleValue: float64(v)}}
+	case float64:
+		return &i18npb.Value{Kind: &i18npb.Value_DoubleValue{DoubleValue: v}}
+	case bool:
+		return &i18npb.Value{Kind: &i18npb.Value_BoolValue{BoolValue: v}}
+	case Message:
+		return &i18npb.Value{Kind: &i18npb.Value_MessageArg{MessageArg: &i18npb.Message{
+			Section: v.section,
+			Key:     v.key,
+			Values:  valuesToProto(v.values),
+		}}}
+	case intArg:
+		n := int64(v.abs)
+		if v.negative {
+			n = -n
+		}
+		return &i18npb.Value{Kind: &i18npb.Value_IntArg{IntArg: n}}
+	case floatArg:
+		return &i18npb.Value{Kind: &i18npb.Value_FloatArg{FloatArg: &i18npb.Float{
+			Value:     v.value,
+			Precision: int32(v.precision),
+		}}}
+	case moneyArg:
+		return &i18npb.Value{Kind: &i18npb.Value_MoneyArg{MoneyArg: &i18npb.Money{
+			Amount:   v.amount,
+			Currency: v.currency,
+		}}}
+	case dateArg:
+		return &i18npb.Value{Kind: &i18npb.Value_DateArg{DateArg: timestamppb.New(time.Time(v))}}
+	case error:
+		return &i18npb.Value{Kind: &i18npb.Value_StringValue{StringValue: v.Error()}}
+	case fmt.Stringer:
+		return &i18npb.Value{Kind: &i18npb.Value_StringValue{StringValue: v.String()}}
+	default:
+		return &i18npb.Value{Kind: &i18npb.Value_StringValue{StringValue: fmt.Sprint(v)}}
+	}
+}
+
+// valuesFromProto Returns values restored from protobuf values
+func valuesFromProto(values map[string]*i18npb.Value) M {
+	if len(values) == 0 {
+		return nil
+	}
+	m := make(M, len(values))
+	for name, value := range values {
+		if v := valueFromProto(value); v != nil {
+			m[name] = v
+		}
+	}
+	return m
+}
+
+func valueFromProto(value *i18npb.Value) interface{} {
+	switch v := value.GetKind().(type) {
+	case *i18npb.Value_StringValue:
+		return v.StringValue
+	case *i18npb.Value_IntValue:
+		return v.IntValue
+	case *i18npb.Value_UintValue:
+		return v.UintValue
+	case *i18npb.Value_DoubleValue:
+		return v.DoubleValue
+	case *i18npb.Value_BoolValue:
+		return v.BoolValue
+	case *i18npb.Value_MessageArg:
+		return Message{
+			section: v.MessageArg.GetSection(),
+			key:     v.MessageArg.GetKey(),
+			values:  valuesFromProto(v.MessageArg.GetValues()),
+		}
+	case *i18npb.Value_IntArg:
+		return Int(v.IntArg)
+	case *i18npb.Value_FloatArg:
+		return Float(v.FloatArg.GetValue(), int(v.FloatArg.GetPrecision()))
+	case *i18npb.Value_MoneyArg:
+		return Money(v.MoneyArg.GetAmount(), v.MoneyArg.GetCurrency())
+	case *i18npb.Value_DateArg:
+		return Date(v.DateArg.AsTime())
+	default:
+		return nil
+	}
+}
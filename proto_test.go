@@ -0,0 +1,128 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/censync/go-i18n/i18npb"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestI18nError_ToProto(t *testing.T) {
+	dict := &Dictionary{
+		"cart": {
+			"summary": "{count} items, {sum} until {date}, {ratio} {hint} {name} {qty} {ok} {weight}",
+			"hint":    "Hint for {product}",
+		},
+	}
+	de := testTranslator(t, "de", dict)
+	date := time.Date(2024, time.March, 7, 10, 0, 0, 0, time.UTC)
+
+	src := NewErrWithCode(400, "cart", "summary", M{
+		"{count}":  Int(-1234567),
+		"{sum}":    Money(123456, "EUR"),
+		"{date}":   Date(date),
+		"{ratio}":  Float(0.12345, 2),
+		"{hint}":   Msg("cart", "hint", M{"{product}": "Acme"}),
+		"{name}":   "Acme",
+		"{qty}":    uint8(3),
+		"{ok}":     true,
+		"{weight}": 1.5,
+		"{nil}":    nil,
+	})
+	src.SetLocale("de")
+
+	data, err := proto.Marshal(src.ToProto())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &i18npb.I18NError{}
+	if err = proto.Unmarshal(data, m); err != nil {
+		t.Fatal(err)
+	}
+	got := ErrFromProto(m)
+
+	if got.code != 400 || got.section != "cart" || got.key != "summary" {
+		t.Errorf("ErrFromProto() = %d %s.%s", got.code, got.section, got.key)
+	}
+	if got.locale == nil || *got.locale != "de" {
+		t.Errorf("ErrFromProto() locale = %v, want de", got.locale)
+	}
+	if _, ok := got.values["{nil}"]; ok {
+		t.Error("ErrFromProto() restored nil value")
+	}
+	want := de.Tf("cart", "summary", src.values)
+	if rendered := de.Tf("cart", "summary", got.values); rendered != want {
+		t.Errorf("ErrFromProto() rendered = %v, want %v", rendered, want)
+	}
+	if got.values["{qty}"] != uint64(3) || got.values["{weight}"] != 1.5 {
+		t.Errorf("ErrFromProto() values = %v", got.values)
+	}
+
+	if (*I18nError)(nil).ToProto() != nil || ErrFromProto(nil) != nil {
+		t.Error("nil conversion must return nil")
+	}
+}
+
+func TestErrFromProto_AnyValues(t *testing.T) {
+	// Message of previous versions with map<string, google.protobuf.Any> values = 3
+	value, err := anypb.New(wrapperspb.Int64(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	valueData, err := proto.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entry []byte
+	entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+	entry = protowire.AppendString(entry, "{min}")
+	entry = protowire.AppendTag(entry, 2, protowire.BytesType)
+	entry = protowire.AppendBytes(entry, valueData)
+
+	var data []byte
+	data = protowire.AppendTag(data, 1, protowire.BytesType)
+	data = protowire.AppendString(data, "errors")
+	data = protowire.AppendTag(data, 2, protowire.BytesType)
+	data = protowire.AppendString(data, "min")
+	data = protowire.AppendTag(data, 3, protowire.BytesType)
+	data = protowire.AppendBytes(data, entry)
+
+	m := &i18npb.I18NError{}
+	if err = proto.Unmarshal(data, m); err != nil {
+		t.Fatal(err)
+	}
+	got := ErrFromProto(m)
+	if got.section != "errors" || got.key != "min" || len(got.values) != 0 {
+		t.Errorf("ErrFromProto() = %s.%s %v, want errors.min without values", got.section, got.key, got.values)
+	}
+}
+
+func TestI18nMultipleError_ToProto(t *testing.T) {
+	src := NewMultipleEmptyErr().WithCode(422).WithLocale("en")
+	src.errors = map[string][]*BaseError{
+		"email": {{section: "errors", key: "invalid_email"}, {section: "errors", key: "taken"}},
+		"age":   {{section: "errors", key: "min", values: M{"{min}": int64(18)}}},
+	}
+
+	data, err := proto.Marshal(src.ToProto())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &i18npb.I18NMultipleError{}
+	if err = proto.Unmarshal(data, m); err != nil {
+		t.Fatal(err)
+	}
+	got := MultipleErrFromProto(m)
+
+	if got.code != 422 || got.locale == nil || *got.locale != "en" {
+		t.Errorf("MultipleErrFromProto() code = %d, locale = %v", got.code, got.locale)
+	}
+	if !reflect.DeepEqual(got.errors, src.errors) {
+		t.Errorf("MultipleErrFromProto() errors = %v, want %v", got.errors, src.errors)
+	}
+}
@@ -0,0 +1,204 @@
+package i18n
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strconv"
+)
+
+// LogValue Returns structured error: section, key, values, code, locale, cause
+// and message translated to default locale
+func (e *I18nError) LogValue() slog.Value {
+	return e.logValue(getTranslator(""))
+}
+
+// logValue Returns structured error with message translated by tr, message is omitted when tr is nil,
+// empty string for error without BaseError
+func (e *I18nError) logValue(tr *Translator) slog.Value {
+	if e.BaseError == nil {
+		return slog.StringValue("")
+	}
+	attrs := e.BaseError.logAttrs(tr, "")
+	if e.code != 0 {
+		attrs = append(attrs, slog.Int("code", e.code))
+	}
+	if e.locale != nil {
+		attrs = append(attrs, slog.String("locale", *e.locale))
+	}
+	if e.cause != nil {
+		attrs = append(attrs, slog.String("cause", e.cause.Error()))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue Returns structured errors of fields with code, locale
+// and messages translated to default locale
+func (e *I18nMultipleError) LogValue() slog.Value {
+	return e.logValue(getTranslator(""))
+}
+
+func (e *I18nMultipleError) logValue(tr *Translator) slog.Value {
+	fields := e.fields()
+	attrs := make([]slog.Attr, 0, len(fields)+2)
+	if e.code != 0 {
+		attrs = append(attrs, slog.Int("code", e.code))
+	}
+	if e.locale != nil {
+		attrs = append(attrs, slog.String("locale", *e.locale))
+	}
+	for _, field := range fields {
+		var label string
+		if tr != nil {
+			label = e.label(tr, field)
+		}
+		fieldErrs := e.errors[field]
+		if len(fieldErrs) == 1 {
+			attrs = append(attrs, slog.Attr{
+				Key:   field,
+				Value: slog.GroupValue(fieldErrs[0].logAttrs(tr, label)...),
+			})
+			continue
+		}
+		// Several errors of field are grouped by index
+		list := make([]slog.Attr, len(fieldErrs))
+		for i, fieldErr := range fieldErrs {
+			list[i] = slog.Attr{
+				Key:   strconv.Itoa(i),
+				Value: slog.GroupValue(fieldErr.logAttrs(tr, label)...),
+			}
+		}
+		attrs = append(attrs, slog.Attr{Key: field, Value: slog.GroupValue(list...)})
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// logAttrs Returns section, key, values and translated message attributes,
+// typed arguments are formatted by tr, label fills {field} of message
+func (e *BaseError) logAttrs(tr *Translator, label string) []slog.Attr {
+	if e == nil {
+		return nil
+	}
+	attrs := []slog.Attr{
+		slog.String("section", e.section),
+		slog.String("key", e.key),
+	}
+	if len(e.values) > 0 {
+		names := make([]string, 0, len(e.values))
+		for name := range e.values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		values := make([]slog.Attr, 0, len(names))
+		for _, name := range names {
+			value := e.values[name]
+			if arg, ok := value.(Arg); ok && tr != nil {
+				value = string(arg.AppendArg(nil, tr))
+			}
+			values = append(values, slog.Any(name, value))
+		}
+		attrs = append(attrs, slog.Attr{Key: "values", Value: slog.GroupValue(values...)})
+	}
+	if tr != nil {
+		attrs = append(attrs, slog.String("message", e.translate(tr, label)))
+	}
+	return attrs
+}
+
+// LogHandler slog.Handler wrapper translating i18n errors of attributes to locale,
+// including errors wrapped by other errors
+type LogHandler struct {
+	handler slog.Handler
+	locale  string
+}
+
+// NewLogHandler Creates LogHandler, empty locale is default locale
+func NewLogHandler(handler slog.Handler, locale string) *LogHandler {
+	return &LogHandler{
+		handler: handler,
+		locale:  locale,
+	}
+}
+
+// Enabled Reports whether wrapped handler handles records of level
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle Translates i18n errors of record attributes and passes record to wrapped handler
+func (h *LogHandler) Handle(ctx context.Context, r slog.Record) error {
+	tr := getTranslator(h.locale)
+	translated := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		translated.AddAttrs(translateAttr(a, tr))
+		return true
+	})
+	return h.handler.Handle(ctx, translated)
+}
+
+// WithAttrs Returns handler with translated attributes
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	tr := getTranslator(h.locale)
+	translated := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		translated[i] = translateAttr(a, tr)
+	}
+	return &LogHandler{
+		handler: h.handler.WithAttrs(translated),
+		locale:  h.locale,
+	}
+}
+
+// WithGroup Returns handler with group
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	return &LogHandler{
+		handler: h.handler.WithGroup(name),
+		locale:  h.locale,
+	}
+}
+
+// translateAttr Returns attribute with i18n errors translated by tr
+func translateAttr(a slog.Attr, tr *Translator) slog.Attr {
+	switch a.Value.Kind() {
+	case slog.KindGroup:
+		group := a.Value.Group()
+		attrs := make([]slog.Attr, len(group))
+		for i, ga := range group {
+			attrs[i] = translateAttr(ga, tr)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(attrs...)}
+	case slog.KindAny, slog.KindLogValuer:
+		err, ok := a.Value.Any().(error)
+		if !ok {
+			return a
+		}
+		var value slog.Value
+		i18nErr, multipleErr := FindErr(err)
+		switch {
+		case multipleErr != nil:
+			value = multipleErr.logValue(tr)
+			if error(multipleErr) != err {
+				value = withErrorAttr(value, err)
+			}
+		case i18nErr != nil:
+			value = i18nErr.logValue(tr)
+			if error(i18nErr) != err {
+				value = withErrorAttr(value, err)
+			}
+		default:
+			return a
+		}
+		return slog.Attr{Key: a.Key, Value: value}
+	default:
+		return a
+	}
+}
+
+// withErrorAttr Returns group with message of wrapping error
+func withErrorAttr(value slog.Value, err error) slog.Value {
+	if value.Kind() != slog.KindGroup {
+		return slog.StringValue(err.Error())
+	}
+	return slog.GroupValue(append([]slog.Attr{slog.String("error", err.Error())}, value.Group()...)...)
+}
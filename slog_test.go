@@ -0,0 +1,161 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"testing"
+)
+
+func TestI18nError_LogValue(t *testing.T) {
+	if err := initDict(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		handler func(buf *bytes.Buffer) slog.Handler
+		err     error
+		want    map[string]interface{}
+	}{
+		{
+			name: "default locale",
+			handler: func(buf *bytes.Buffer) slog.Handler {
+				return slog.NewJSONHandler(buf, nil)
+			},
+			err: NewErrWithCode(400, "fields.errors", "to_short", M{"{min}": Int(1000)}),
+			want: map[string]interface{}{
+				"section": "fields.errors",
+				"key":     "to_short",
+				"values":  map[string]interface{}{"{min}": "1,000"},
+				"message": "Field too short",
+				"code":    float64(400),
+			},
+		},
+		{
+			name: "handler locale",
+			handler: func(buf *bytes.Buffer) slog.Handler {
+				return NewLogHandler(slog.NewJSONHandler(buf, nil), "cz")
+			},
+			err: NewErr("fields.errors", "to_long").WithLocale("en"),
+			want: map[string]interface{}{
+				"section": "fields.errors",
+				"key":     "to_long",
+				"message": "Pole je příliš dlouhé",
+				"locale":  "en",
+			},
+		},
+		{
+			name: "wrapped",
+			handler: func(buf *bytes.Buffer) slog.Handler {
+				return NewLogHandler(slog.NewJSONHandler(buf, nil), "cz")
+			},
+			err: fmt.Errorf("signup: %w", NewErr("fields.errors", "to_long")),
+			want: map[string]interface{}{
+				"error":   "signup: fields.errors.to_long",
+				"section": "fields.errors",
+				"key":     "to_long",
+				"message": "Pole je příliš dlouhé",
+			},
+		},
+		{
+			name: "wrapping multiple",
+			handler: func(buf *bytes.Buffer) slog.Handler {
+				return NewLogHandler(slog.NewJSONHandler(buf, nil), "cz")
+			},
+			err: WrapErr(NewMultipleErr("name", "fields.errors", "to_short"), "fields.errors", "to_long"),
+			want: map[string]interface{}{
+				"cause":   `{"name":{"fields.errors":"to_short"}}`,
+				"section": "fields.errors",
+				"key":     "to_long",
+				"message": "Pole je příliš dlouhé",
+			},
+		},
+		{
+			name: "multiple",
+			handler: func(buf *bytes.Buffer) slog.Handler {
+				return NewLogHandler(slog.NewJSONHandler(buf, nil), "cz")
+			},
+			err: NewMultipleErr("name", "fields.errors", "to_short"),
+			want: map[string]interface{}{
+				"name": map[string]interface{}{
+					"section": "fields.errors",
+					"key":     "to_short",
+					"message": "Pole je příliš krátké",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			slog.New(tt.handler(&buf)).Error("failed", "err", tt.err)
+
+			var record struct {
+				Err map[string]interface{} `json:"err"`
+			}
+			if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+				t.Fatal(err, buf.String())
+			}
+			if !reflect.DeepEqual(record.Err, tt.want) {
+				t.Errorf("log err = %v, want %v", record.Err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogHandler_WithAttrs(t *testing.T) {
+	if err := initDict(); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(NewLogHandler(slog.NewTextHandler(&buf, nil), "cz")).
+		With("err", NewErr("fields.errors", "to_short")).
+		WithGroup("request")
+	logger.Info("plain", "id", 1)
+
+	if got := buf.String(); !bytes.Contains([]byte(got), []byte(`err.message="Pole je příliš krátké"`)) ||
+		!bytes.Contains([]byte(got), []byte("request.id=1")) {
+		t.Errorf("log = %v", got)
+	}
+}
+
+func TestI18nError_LogValue_NotInitialized(t *testing.T) {
+	prev := current.Swap(nil)
+	defer current.Store(prev)
+
+	value := NewErr("fields.errors", "to_short").LogValue()
+	for _, a := range value.Group() {
+		if a.Key == "message" {
+			t.Errorf("LogValue() message = %v, want omitted", a.Value)
+		}
+	}
+}
+
+func TestI18nError_LogValue_Empty(t *testing.T) {
+	if err := initDict(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, handler := range []func(buf *bytes.Buffer) slog.Handler{
+		func(buf *bytes.Buffer) slog.Handler { return slog.NewJSONHandler(buf, nil) },
+		func(buf *bytes.Buffer) slog.Handler { return NewLogHandler(slog.NewJSONHandler(buf, nil), "cz") },
+	} {
+		var buf bytes.Buffer
+		slog.New(handler(&buf)).Error("failed", "err", &I18nError{})
+
+		var record struct {
+			Err string `json:"err"`
+		}
+		if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+			t.Fatal(err, buf.String())
+		}
+		if record.Err != "" {
+			t.Errorf("log err = %q, want empty", record.Err)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package i18n
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+// Source Provider of dictionaries collection
+type Source interface {
+	Load(ctx context.Context) (*DictionaryCollection, error)
+}
+
+// Watcher Optional Source interface for sources able to report changes.
+// Watch calls changed on each change until ctx is done.
+type Watcher interface {
+	Watch(ctx context.Context, changed func()) error
+}
+
+// InitFromSource Initialize translator with dictionaries loaded from source
+func InitFromSource(ctx context.Context, defaultLocale string, src Source, locales ...string) error {
+	collection, err := src.Load(ctx)
+	if err != nil {
+		return err
+	}
+	return Init(defaultLocale, collection, locales...)
+}
+
+// WatchSource Reloads dictionaries from source on each change, until ctx is done.
+// Source must implement Watcher. Reload keeps the current default and available locales,
+// reload errors are passed to onError and previous dictionaries stay loaded.
+func WatchSource(ctx context.Context, src Source, onError func(error)) error {
+	watcher, ok := src.(Watcher)
+	if !ok {
+		return errors.New("source does not support watching")
+	}
+	return watcher.Watch(ctx, func() {
+		err := InitFromSource(ctx, DefaultLocale(), src, AvailableLocales()...)
+		if err != nil && onError != nil {
+			onError(err)
+		}
+	})
+}
+
+// DirSource Loads "locale.json" files from directory, all files when Locales is empty
+type DirSource struct {
+	Path    string
+	Locales []string
+}
+
+// Load Returns dictionaries from directory files
+func (s *DirSource) Load(_ context.Context) (*DictionaryCollection, error) {
+	locales := s.Locales
+	if len(locales) == 0 {
+		var err error
+		if locales, err = listDictionaryFiles(s.Path); err != nil {
+			return nil, err
+		}
+	}
+	collection := DictionaryCollection{}
+	for _, locale := range locales {
+		dict, err := loadDictionaryFile(s.Path, locale)
+		if err != nil {
+			return nil, err
+		}
+		collection[locale] = dict
+	}
+	return &collection, nil
+}
+
+var sqlIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// SQLSource Loads dictionaries from table with (locale, section, key, value) rows
+type SQLSource struct {
+	DB *sql.DB
+	// Query must return locale, section, key and value columns
+	Query string
+}
+
+// NewSQLSource Returns SQLSource for table with locale, section, key and value columns.
+// Columns are qualified by table alias, so reserved words, e.g. key of MySQL, need no quoting,
+// set Query for databases requiring quoted identifiers
+func NewSQLSource(db *sql.DB, table string) (*SQLSource, error) {
+	if !sqlIdentifier.MatchString(table) {
+		return nil, fmt.Errorf("invalid table name %q", table)
+	}
+	return &SQLSource{
+		DB:    db,
+		Query: "SELECT t.locale, t.section, t.key, t.value FROM " + table + " t",
+	}, nil
+}
+
+// Load Returns dictionaries from query rows
+func (s *SQLSource) Load(ctx context.Context) (*DictionaryCollection, error) {
+	rows, err := s.DB.QueryContext(ctx, s.Query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	collection := DictionaryCollection{}
+	for rows.Next() {
+		var locale, section, key, value string
+		if err = rows.Scan(&locale, &section, &key, &value); err != nil {
+			return nil, err
+		}
+		collection.set(locale, section, key, value)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// HTTPSource Loads dictionaries collection in JSON format from URL,
+// e.g. {"en": {"section": {"key": "translation"}}}
+type HTTPSource struct {
+	URL string
+	// Client is http.DefaultClient, when not set
+	Client *http.Client
+	// Interval is polling interval of Watch, one minute when not set
+	Interval time.Duration
+}
+
+// Load Returns dictionaries from URL
+func (s *HTTPSource) Load(ctx context.Context) (*DictionaryCollection, error) {
+	body, err := s.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	collection := DictionaryCollection{}
+	if err = json.Unmarshal(body, &collection); err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// Watch Polls URL and calls changed when response body differs from previous one
+func (s *HTTPSource) Watch(ctx context.Context, changed func()) error {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last [sha256.Size]byte
+	if body, err := s.fetch(ctx); err == nil {
+		last = sha256.Sum256(body)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			body, err := s.fetch(ctx)
+			if err != nil {
+				continue
+			}
+			if sum := sha256.Sum256(body); sum != last {
+				last = sum
+				changed()
+			}
+		}
+	}
+}
+
+func (s *HTTPSource) fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s loading %s", resp.Status, s.URL)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// MultiSource Composes sources, entries of later sources take priority over earlier ones
+func MultiSource(sources ...Source) Source {
+	return multiSource(sources)
+}
+
+type multiSource []Source
+
+// Load Returns merged dictionaries of all sources
+func (s multiSource) Load(ctx context.Context) (*DictionaryCollection, error) {
+	collection := DictionaryCollection{}
+	for _, src := range s {
+		loaded, err := src.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		collection.merge(loaded)
+	}
+	return &collection, nil
+}
+
+// Watch Watches all sources implementing Watcher, returns when ctx is done or any watch fails
+func (s multiSource) Watch(ctx context.Context, changed func()) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make(chan error, len(s))
+	watching := 0
+	for _, src := range s {
+		if watcher, ok := src.(Watcher); ok {
+			watching++
+			go func(w Watcher) {
+				errs <- w.Watch(ctx, changed)
+			}(watcher)
+		}
+	}
+	if watching == 0 {
+		return errors.New("no source supports watching")
+	}
+	return <-errs
+}
+
+// set Sets translation, creating locale and section when needed
+func (c DictionaryCollection) set(locale, section, key, value string) {
+	dict, ok := c[locale]
+	if !ok {
+		dict = &Dictionary{}
+		c[locale] = dict
+	}
+	dict.set(section, key, value)
+}
+
+// set Sets translation, creating section when needed
+func (d Dictionary) set(section, key, value string) {
+	entry, ok := d[section]
+	if !ok {
+		entry = &DictionaryEntry{}
+		d[section] = entry
+	}
+	(*entry)[key] = value
+}
+
+// merge Copies entries of other collection, replacing existing ones
+func (c DictionaryCollection) merge(other *DictionaryCollection) {
+	if other == nil {
+		return
+	}
+	for locale, dict := range *other {
+		if dict == nil {
+			continue
+		}
+		for section, entry := range *dict {
+			if entry == nil {
+				continue
+			}
+			for key, value := range *entry {
+				c.set(locale, section, key, value)
+			}
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package i18n
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeDriver Serves fixed rows for any query, args of executed statements
+// are added as rows of connection
+type fakeDriver struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) {
+	columns := d.columns
+	if columns == nil {
+		columns = []string{"locale", "section", "key", "value"}
+	}
+	return &fakeConn{columns: columns, rows: d.rows}, nil
+}
+
+type fakeConn struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions not supported")
+}
+
+type fakeStmt struct {
+	conn *fakeConn
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.rows = append(s.conn.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{columns: s.conn.columns, rows: s.conn.rows}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+	pos     int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
+
+func init() {
+	sql.Register("i18n_fake", &fakeDriver{
+		rows: [][]driver.Value{
+			{"en", "form.signup", "welcome", "Welcome to registration"},
+			{"en", "form.signup", "disabled", "Registration is temporarily unavailable"},
+			{"cz", "form.signup", "welcome", "Vítejte v registraci"},
+		},
+	})
+	sql.Register("i18n_store", &fakeDriver{columns: []string{"value"}})
+}
+
+func TestSQLSource_Load(t *testing.T) {
+	db, err := sql.Open("i18n_fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	src, err := NewSQLSource(db, "translations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = InitFromSource(context.Background(), "en", src); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := Get("cz").T("form.signup", "welcome"); got != "Vítejte v registraci" {
+		t.Errorf("Get().T() = %v", got)
+	}
+	if got := Get("en").T("form.signup", "disabled"); got != "Registration is temporarily unavailable" {
+		t.Errorf("Get().T() = %v", got)
+	}
+
+	if src.Query != "SELECT t.locale, t.section, t.key, t.value FROM translations t" {
+		t.Errorf("NewSQLSource() query = %v", src.Query)
+	}
+	if _, err = NewSQLSource(db, "translations; DROP TABLE users"); err == nil {
+		t.Error("NewSQLSource() error = nil, want invalid table name")
+	}
+}
+
+func TestHTTPSource_Load(t *testing.T) {
+	var version atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/translations" {
+			http.NotFound(w, r)
+			return
+		}
+		if version.Load() == 0 {
+			_, _ = w.Write([]byte(`{"en": {"form.login": {"title": "Hello, {name}"}}}`))
+		} else {
+			_, _ = w.Write([]byte(`{"en": {"form.login": {"title": "Hi, {name}"}}}`))
+		}
+	}))
+	defer srv.Close()
+
+	src := &HTTPSource{URL: srv.URL + "/translations", Interval: 10 * time.Millisecond}
+	if err := InitFromSource(context.Background(), "en", src); err != nil {
+		t.Fatal(err)
+	}
+	if got := Get("en").Tf("form.login", "title", M{"{name}": "John"}); got != "Hello, John" {
+		t.Errorf("Get().Tf() = %v", got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	reloaded := make(chan struct{})
+	go func() {
+		_ = src.Watch(ctx, func() {
+			if err := InitFromSource(ctx, "en", src); err != nil {
+				t.Error(err)
+			}
+			close(reloaded)
+			cancel()
+		})
+	}()
+	time.Sleep(30 * time.Millisecond)
+	version.Store(1)
+
+	select {
+	case <-reloaded:
+	case <-ctx.Done():
+		t.Fatal("HTTPSource.Watch() did not report change")
+	}
+	if got := Get("en").Tf("form.login", "title", M{"{name}": "John"}); got != "Hi, John" {
+		t.Errorf("Get().Tf() after reload = %v", got)
+	}
+
+	missing := &HTTPSource{URL: srv.URL + "/missing"}
+	if _, err := missing.Load(context.Background()); err == nil {
+		t.Error("HTTPSource.Load() error = nil, want status error")
+	}
+}
+
+// onceSource Serves fixed collection and reports single change
+type onceSource struct {
+	collection DictionaryCollection
+}
+
+func (s *onceSource) Load(context.Context) (*DictionaryCollection, error) {
+	return &s.collection, nil
+}
+
+func (s *onceSource) Watch(_ context.Context, changed func()) error {
+	changed()
+	return nil
+}
+
+func TestWatchSource_Locales(t *testing.T) {
+	src := &onceSource{collection: DictionaryCollection{
+		"en": {"errors": {"unknown": "Unknown error"}},
+		"cz": {"errors": {"unknown": "Neznámá chyba"}},
+		"de": {"errors": {"unknown": "Unbekannter Fehler"}},
+	}}
+	if err := InitFromSource(context.Background(), "cz", src, "en", "cz"); err != nil {
+		t.Fatal(err)
+	}
+
+	err := WatchSource(context.Background(), src, func(err error) {
+		t.Error(err)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := AvailableLocales(); !reflect.DeepEqual(got, []string{"en", "cz"}) {
+		t.Errorf("AvailableLocales() after reload = %v, want [en cz]", got)
+	}
+	if got := Get("de").T("errors", "unknown"); got != "Neznámá chyba" {
+		t.Errorf("Get(de).T() after reload = %v, want default locale", got)
+	}
+}
+
+func TestMultiSource_Load(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "en.json"), []byte(`{"errors": {"unknown": "Unknown error", "internal": "Server error"}}`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"en": {"errors": {"unknown": "Something went wrong"}}, "cz": {"errors": {"unknown": "Neznámá chyba"}}}`))
+	}))
+	defer srv.Close()
+
+	src := MultiSource(&DirSource{Path: dir}, &HTTPSource{URL: srv.URL})
+	if err = InitFromSource(context.Background(), "en", src); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		locale string
+		key    string
+		want   string
+	}{
+		{"en", "unknown", "Something went wrong"},
+		{"en", "internal", "Server error"},
+		{"cz", "unknown", "Neznámá chyba"},
+	}
+	for _, tt := range tests {
+		if got := Get(tt.locale).T("errors", tt.key); got != tt.want {
+			t.Errorf("Get(%v).T(%v) = %v, want %v", tt.locale, tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestDirSource_Load_MissingDir(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing")
+	tests := []struct {
+		name string
+		src  Source
+	}{
+		{"dir", &DirSource{Path: missing}},
+		{"dir with locales", &DirSource{Path: missing, Locales: []string{"en"}}},
+		{"multi", MultiSource(&DirSource{Path: missing})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collection, err := tt.src.Load(context.Background())
+			if !errors.Is(err, os.ErrNotExist) {
+				t.Errorf("Load() = %v, %v, want not exist error", collection, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Value Returns JSONFull form of error for database/sql, nil error is NULL.
+// Cause is not stored, so error can be scanned and translated later in any locale
+func (e *I18nError) Value() (driver.Value, error) {
+	if e == nil || e.BaseError == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(e.WithFormat(JSONFull))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan Reads error stored by Value, NULL is error with empty section and key.
+// Scanned error is encoded in JSONCompact form, see WithFormat
+func (e *I18nError) Scan(src interface{}) error {
+	data, err := scanJSON(src, "I18nError")
+	if err != nil || data == nil {
+		*e = I18nError{BaseError: &BaseError{}}
+		return err
+	}
+	if err = json.Unmarshal(data, e); err != nil {
+		return err
+	}
+	e.format = JSONCompact
+	return nil
+}
+
+// Value Returns JSONFull form of errors for database/sql, error without fields is NULL
+func (e *I18nMultipleError) Value() (driver.Value, error) {
+	if e == nil || len(e.errors) == 0 {
+		return nil, nil
+	}
+	b, err := e.marshalFull()
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan Reads errors stored by Value, NULL is error without fields.
+// Scanned error is encoded in JSONCompact form, see WithFormat
+func (e *I18nMultipleError) Scan(src interface{}) error {
+	data, err := scanJSON(src, "I18nMultipleError")
+	if err != nil || data == nil {
+		*e = *NewMultipleEmptyErr()
+		return err
+	}
+	if err = json.Unmarshal(data, e); err != nil {
+		return err
+	}
+	e.format = JSONCompact
+	return nil
+}
+
+// Value Returns {"s": section, "k": key, "v": values, "l": locale} form of message
+// for database/sql, empty message is NULL
+func (m Message) Value() (driver.Value, error) {
+	if m.section == "" && m.key == "" {
+		return nil, nil
+	}
+	b, err := json.Marshal(errorFull{
+		Section: m.section,
+		Key:     m.key,
+		Values:  jsonValues(m.values),
+		Locale:  m.locale,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan Reads message stored by Value, NULL is empty message.
+// Translated message, e.g. stored by MarshalJSON, is restored as is, see UnmarshalJSON
+func (m *Message) Scan(src interface{}) error {
+	data, err := scanJSON(src, "Message")
+	if err != nil || data == nil {
+		*m = Message{}
+		return err
+	}
+	return m.UnmarshalJSON(data)
+}
+
+// scanJSON Returns JSON of database value, nil for NULL
+func scanJSON(src interface{}, name string) ([]byte, error) {
+	switch v := src.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, fmt.Errorf("i18n: cannot scan %T into %s", src, name)
+	}
+}
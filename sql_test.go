@@ -0,0 +1,160 @@
+package i18n
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// storeAndScan Stores value and scans it into dst
+func storeAndScan(t *testing.T, value interface{}, dst interface{}) {
+	t.Helper()
+	db, err := sql.Open("i18n_store", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	// Values are stored in connection
+	db.SetMaxOpenConns(1)
+
+	if _, err = db.Exec("INSERT INTO failures VALUES (?)", value); err != nil {
+		t.Fatal(err)
+	}
+	if err = db.QueryRow("SELECT value FROM failures").Scan(dst); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestI18nError_Scan(t *testing.T) {
+	dict := &Dictionary{
+		"jobs": {
+			"failed": "{job} failed {times} times, cost {cost}, since {date}, ratio {ratio}: {reason}",
+			"disk":   "Disk {disk} is full",
+		},
+	}
+	cs := testTranslator(t, "cs", dict)
+	src := NewErrWithCode(500, "jobs", "failed", M{
+		"{job}":    "export",
+		"{times}":  Int(-1234),
+		"{cost}":   Money(129950, "CZK"),
+		"{date}":   Date(time.Date(2024, time.March, 7, 10, 0, 0, 0, time.UTC)),
+		"{ratio}":  Float(0.125, 2),
+		"{reason}": Msg("jobs", "disk", M{"{disk}": "/dev/sda"}),
+	}).WithLocale("cz").Wrap(errors.New("exit status 1"))
+
+	got := &I18nError{}
+	storeAndScan(t, src, got)
+
+	if got.Code() != 500 || got.Locale() == nil || *got.Locale() != "cz" || got.Format() != JSONCompact {
+		t.Errorf("Scan() code = %d, locale = %v, format = %d", got.Code(), got.Locale(), got.Format())
+	}
+	if got.Unwrap() != nil {
+		t.Errorf("Scan() cause = %v, want nil", got.Unwrap())
+	}
+	if rendered, want := got.Tf(cs), src.Tf(cs); rendered != want {
+		t.Errorf("Scan() rendered = %s, want %s", rendered, want)
+	}
+
+	var empty *I18nError
+	got = NewErr("jobs", "failed")
+	storeAndScan(t, empty, got)
+	if got.BaseError == nil || got.Section() != "" || got.Key() != "" || got.Code() != 0 {
+		t.Fatalf("Scan(NULL) = %#v, want empty error", got)
+	}
+	// Empty error is usable, e.g. rows with NULL failure are rendered without panic
+	if msg := got.Error(); msg != "" {
+		t.Errorf("Scan(NULL) error = %q, want empty", msg)
+	}
+	_ = got.Tf(cs)
+}
+
+func TestI18nMultipleError_Scan(t *testing.T) {
+	src := NewMultipleErr("email", "errors", "required").
+		Add("age", "errors", "min", M{"{min}": Int(18)}).
+		Add("age", "errors", "max", M{"{max}": 99}).
+		WithLocale("en")
+
+	got := NewMultipleEmptyErr()
+	storeAndScan(t, src, got)
+
+	want := map[string][]*BaseError{
+		"email": {{section: "errors", key: "required"}},
+		"age": {
+			{section: "errors", key: "min", values: map[string]interface{}{"{min}": Int(18)}},
+			{section: "errors", key: "max", values: map[string]interface{}{"{max}": 99.0}},
+		},
+	}
+	if !reflect.DeepEqual(got.FieldErrors(), want) {
+		t.Errorf("Scan() errors = %v, want %v", got.FieldErrors(), want)
+	}
+	if got.Code() != 400 || got.Locale() == nil || *got.Locale() != "en" || got.format != JSONCompact {
+		t.Errorf("Scan() code = %d, locale = %v, format = %d", got.Code(), got.Locale(), got.format)
+	}
+
+	storeAndScan(t, NewMultipleEmptyErr(), got)
+	if got.HasErrors() {
+		t.Errorf("Scan(NULL) = %v, want no errors", got)
+	}
+}
+
+func TestMessage_Scan(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  Message
+	}{
+		{
+			name:  "message",
+			value: Msg("notifications", "shipped", M{"{id}": Int(42), "{by}": Msg("carriers", "dhl")}).WithLocale("cz"),
+			want: Message{
+				section: "notifications",
+				key:     "shipped",
+				values:  M{"{id}": Int(42), "{by}": Msg("carriers", "dhl")},
+				locale:  "cz",
+			},
+		},
+		{
+			name:  "translated message",
+			value: `"Order shipped"`,
+			want:  Message{section: messageErrSection, key: "Order shipped"},
+		},
+		{
+			name:  "empty message",
+			value: Message{},
+			want:  Message{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Msg("other", "message")
+			storeAndScan(t, tt.value, &got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScan_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		dst  sql.Scanner
+		src  interface{}
+	}{
+		{"error type", &I18nError{}, int64(1)},
+		{"error json", &I18nError{}, "{"},
+		{"multiple error type", NewMultipleEmptyErr(), time.Now()},
+		{"message type", &Message{}, true},
+		{"message json", &Message{}, []byte("[1]")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.dst.Scan(tt.src); err == nil {
+				t.Error("Scan() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package i18n
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	// DefaultFallbackSection Section of message for errors without translation
+	DefaultFallbackSection = "errors"
+	// DefaultFallbackKey Key of message for errors without translation
+	DefaultFallbackKey = "unknown"
+)
+
+var fallbackErr atomic.Pointer[BaseError]
+
+// SetFallback Sets message used by Translate for errors without translation,
+// defaults are DefaultFallbackSection and DefaultFallbackKey
+func SetFallback(section string, key string) {
+	fallbackErr.Store(&BaseError{
+		section: section,
+		key:     key,
+	})
+}
+
+// fallback Returns message for errors without translation
+func fallback() *BaseError {
+	if e := fallbackErr.Load(); e != nil {
+		return e
+	}
+	return &BaseError{
+		section: DefaultFallbackSection,
+		key:     DefaultFallbackKey,
+	}
+}
+
+// TranslatedError Translated error, e.g. for API responses
+type TranslatedError struct {
+	// Message Translated message, field messages are joined for multiple errors
+	Message string `json:"message"`
+	// Code Status code of i18n error
+	Code int `json:"code,omitempty"`
+	// Fields Translated messages of multiple error fields
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// Translate Returns translated message of first i18n error of err chain,
+// fallback message for other errors, empty string for nil error
+func Translate(tr *Translator, err error) string {
+	if err == nil {
+		return ""
+	}
+	return TranslateErr(tr, err).Message
+}
+
+// TranslateErr Returns structured translation of first i18n error of err chain,
+// fallback message for other errors, nil for nil error.
+// Nil tr is translator of default locale.
+func TranslateErr(tr *Translator, err error) *TranslatedError {
+	if err == nil {
+		return nil
+	}
+	if tr == nil {
+		if tr = getTranslator(""); tr == nil {
+			return &TranslatedError{Message: err.Error()}
+		}
+	}
+
+	i18nErr, multipleErr := FindErr(err)
+	switch {
+	case multipleErr != nil:
+		return multipleErr.translate(tr)
+	case i18nErr != nil && i18nErr.BaseError != nil:
+		return &TranslatedError{
+			Message: i18nErr.translate(tr, ""),
+			Code:    i18nErr.code,
+		}
+	default:
+		f := fallback()
+		return &TranslatedError{
+			Message: tr.T(f.section, f.key),
+		}
+	}
+}
+
+// FindErr Returns first *I18nError or *I18nMultipleError of err chain, the other result is nil.
+// Chain is walked in errors.As order, so error wrapping other i18n errors wins over them.
+// Both results are nil, when chain has no i18n error
+func FindErr(err error) (*I18nError, *I18nMultipleError) {
+	switch e := err.(type) {
+	case nil:
+		return nil, nil
+	case *I18nError:
+		if e == nil {
+			return nil, nil
+		}
+		return e, nil
+	case *I18nMultipleError:
+		if e == nil {
+			return nil, nil
+		}
+		return nil, e
+	}
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		return FindErr(u.Unwrap())
+	case interface{ Unwrap() []error }:
+		for _, inner := range u.Unwrap() {
+			if i18nErr, multipleErr := FindErr(inner); i18nErr != nil || multipleErr != nil {
+				return i18nErr, multipleErr
+			}
+		}
+	}
+	return nil, nil
+}
+
+// translate Returns translated fields, messages of field are joined with "; ",
+// message is summary followed by "label: message" of other fields sorted by field
+func (e *I18nMultipleError) translate(tr *Translator) *TranslatedError {
+	translated := &TranslatedError{
+		Code:   e.code,
+		Fields: make(map[string]string, len(e.errors)),
+	}
+	fieldMessages := e.FieldMessages(tr)
+	messages := make([]string, 0, len(e.errors))
+	if list, ok := fieldMessages[multipleDefaultErrorField]; ok {
+		msg := strings.Join(list, "; ")
+		translated.Fields[multipleDefaultErrorField] = msg
+		messages = append(messages, msg)
+	}
+	for _, field := range e.fields() {
+		if field == multipleDefaultErrorField {
+			continue
+		}
+		msg := strings.Join(fieldMessages[field], "; ")
+		translated.Fields[field] = msg
+		messages = append(messages, e.label(tr, field)+": "+msg)
+	}
+	translated.Message = strings.Join(messages, "; ")
+	return translated
+}
+
+// translate Returns translated message with {field} filled by label, restored translated messages
+// are returned as is, untranslatable errors added by AddDefaultErr are replaced by fallback message
+func (e *BaseError) translate(tr *Translator, label string) string {
+	switch e.section {
+	case messageErrSection:
+		return e.key
+	case rawErrSection:
+		f := fallback()
+		return tr.T(f.section, f.key)
+	default:
+		return tr.Tf(e.section, e.key, withLabel(e.values, label))
+	}
+}
+
+// translateT Returns translated message without formatting, see translate
+func (e *BaseError) translateT(tr *Translator) string {
+	switch e.section {
+	case messageErrSection, rawErrSection:
+		return e.translate(tr, "")
+	default:
+		return tr.T(e.section, e.key)
+	}
+}
@@ -0,0 +1,121 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFindErr(t *testing.T) {
+	i18nErr := NewErr("errors", "save_failed")
+	multipleErr := NewMultipleErr("email", "errors", "required")
+	wrapping := i18nErr.Wrap(multipleErr)
+
+	tests := []struct {
+		name         string
+		err          error
+		wantI18n     *I18nError
+		wantMultiple *I18nMultipleError
+	}{
+		{"nil", nil, nil, nil},
+		{"plain", errors.New("timeout"), nil, nil},
+		{"i18n error", i18nErr, i18nErr, nil},
+		{"multiple error", multipleErr, nil, multipleErr},
+		{"wrapped multiple error", fmt.Errorf("save: %w", multipleErr), nil, multipleErr},
+		{"i18n error wrapping multiple error", fmt.Errorf("save: %w", wrapping), wrapping, nil},
+		{"joined", errors.Join(errors.New("timeout"), multipleErr, i18nErr), nil, multipleErr},
+		{"nil i18n error", fmt.Errorf("%w", (*I18nError)(nil)), nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotI18n, gotMultiple := FindErr(tt.err)
+			if gotI18n != tt.wantI18n || gotMultiple != tt.wantMultiple {
+				t.Errorf("FindErr() = %v, %v, want %v, %v", gotI18n, gotMultiple, tt.wantI18n, tt.wantMultiple)
+			}
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	dict := &Dictionary{
+		"errors": {
+			"unknown":  "Something went wrong",
+			"internal": "Internal error",
+			"min":      "At least {min}",
+			"required": "Required",
+		},
+	}
+	tr := testTranslator(t, "en", dict)
+	errPlain := errors.New("dial tcp: connection refused")
+
+	tests := []struct {
+		name string
+		err  error
+		want *TranslatedError
+	}{
+		{
+			name: "nil",
+			err:  nil,
+			want: nil,
+		},
+		{
+			name: "i18n error",
+			err:  NewErrWithCode(400, "errors", "min", M{"{min}": 3}),
+			want: &TranslatedError{Message: "At least 3", Code: 400},
+		},
+		{
+			name: "wrapped i18n error",
+			err:  fmt.Errorf("signup: %w", NewErr("errors", "required").Wrap(errPlain)),
+			want: &TranslatedError{Message: "Required"},
+		},
+		{
+			name: "i18n error wrapping multiple error",
+			err:  WrapErr(NewMultipleErr("email", "errors", "required"), "errors", "internal").WithCode(500),
+			want: &TranslatedError{Message: "Internal error", Code: 500},
+		},
+		{
+			name: "restored translated error",
+			err:  &I18nError{BaseError: &BaseError{section: messageErrSection, key: "Ahoj"}, code: 409},
+			want: &TranslatedError{Message: "Ahoj", Code: 409},
+		},
+		{
+			name: "plain error",
+			err:  errPlain,
+			want: &TranslatedError{Message: "Something went wrong"},
+		},
+		{
+			name: "multiple error",
+			err: NewMultipleErr("password", "errors", "min", M{"{min}": 8}).
+				Add("email", "errors", "required").
+				AddDefaultErr(errPlain),
+			want: &TranslatedError{
+				Message: "Something went wrong; email: Required; password: At least 8",
+				Code:    400,
+				Fields: map[string]string{
+					"_summary": "Something went wrong",
+					"email":    "Required",
+					"password": "At least 8",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TranslateErr(tr, tt.err)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TranslateErr() = %+v, want %+v", got, tt.want)
+			}
+			if tt.want != nil && Translate(tr, tt.err) != tt.want.Message {
+				t.Errorf("Translate() = %v, want %v", Translate(tr, tt.err), tt.want.Message)
+			}
+		})
+	}
+
+	SetFallback("errors", "internal")
+	defer fallbackErr.Store(nil)
+	if got := Translate(tr, errPlain); got != "Internal error" {
+		t.Errorf("Translate() with fallback = %v, want Internal error", got)
+	}
+}
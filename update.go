@@ -0,0 +1,284 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ChangeOp Dictionary change operation
+type ChangeOp int
+
+const (
+	OpSet ChangeOp = iota + 1
+	OpDeleteKey
+	OpAddLocale
+	OpRemoveLocale
+)
+
+// String Returns operation name
+func (op ChangeOp) String() string {
+	switch op {
+	case OpSet:
+		return "set"
+	case OpDeleteKey:
+		return "delete_key"
+	case OpAddLocale:
+		return "add_locale"
+	case OpRemoveLocale:
+		return "remove_locale"
+	default:
+		return "unknown"
+	}
+}
+
+// ChangeEvent Published change of loaded dictionaries
+type ChangeEvent struct {
+	Op      ChangeOp
+	Locale  string
+	Section string
+	Key     string
+	Value   string
+}
+
+var (
+	handlersMu sync.Mutex
+	handlers   = map[int]func(ChangeEvent){}
+	handlerSeq int
+)
+
+// OnChange Registers handler called after each published change,
+// returned function unregisters handler
+func OnChange(handler func(ChangeEvent)) func() {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+
+	handlerSeq++
+	id := handlerSeq
+	handlers[id] = handler
+	return func() {
+		handlersMu.Lock()
+		defer handlersMu.Unlock()
+
+		delete(handlers, id)
+	}
+}
+
+// emit Calls change handlers, must be called without holding mu,
+// so handlers are free to read or change dictionaries
+func emit(event ChangeEvent) {
+	handlersMu.Lock()
+	list := make([]func(ChangeEvent), 0, len(handlers))
+	for _, handler := range handlers {
+		list = append(list, handler)
+	}
+	handlersMu.Unlock()
+
+	for _, handler := range list {
+		handler(event)
+	}
+}
+
+// Set Sets translation for locale entry, readers see either previous or new dictionary.
+// Lazily loaded locale is loaded and stays loaded after the change
+func Set(locale, section, key, value string) error {
+	if section == "" || key == "" {
+		return errors.New("section and key must be set")
+	}
+	err := update(locale, func(dict *Dictionary) error {
+		dict.set(section, key, value)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	emit(ChangeEvent{Op: OpSet, Locale: locale, Section: section, Key: key, Value: value})
+	return nil
+}
+
+// DeleteKey Removes locale entry, lazily loaded locale stays loaded as in Set
+func DeleteKey(locale, section, key string) error {
+	err := update(locale, func(dict *Dictionary) error {
+		entry, ok := (*dict)[section]
+		if !ok {
+			return fmt.Errorf("no entry %s.%s", section, key)
+		}
+		if _, ok := (*entry)[key]; !ok {
+			return fmt.Errorf("no entry %s.%s", section, key)
+		}
+		delete(*entry, key)
+		if len(*entry) == 0 {
+			delete(*dict, section)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	emit(ChangeEvent{Op: OpDeleteKey, Locale: locale, Section: section, Key: key})
+	return nil
+}
+
+// AddLocale Loads dictionary for new locale, tenant overlays of locale are applied
+func AddLocale(locale string, dict *Dictionary) error {
+	if locale == "" {
+		return errors.New("locale not set")
+	}
+	if dict == nil {
+		return errors.New("dictionary not set")
+	}
+
+	mu.Lock()
+	prev := load()
+	if prev == nil {
+		mu.Unlock()
+		return errors.New("translator not initialized")
+	}
+	if _, ok := prev.translators[locale]; ok || prev.lazy.has(locale) {
+		mu.Unlock()
+		return fmt.Errorf("locale %s already loaded", locale)
+	}
+
+	tr, err := newTranslator(locale, dict.clone())
+	if err != nil {
+		mu.Unlock()
+		return err
+	}
+	snap := prev.clone()
+	snap.translators[locale] = tr
+	snap.availableLocales = append(append([]string{}, prev.availableLocales...), locale)
+	if err = snap.buildTenantLocale(locale); err != nil {
+		mu.Unlock()
+		return err
+	}
+	current.Store(snap)
+	mu.Unlock()
+
+	emit(ChangeEvent{Op: OpAddLocale, Locale: locale})
+	return nil
+}
+
+// RemoveLocale Unloads locale dictionary, default locale cannot be removed.
+// Lazily loaded locale is not loaded again. Tenant overlays of locale are kept, see SetOverlay
+func RemoveLocale(locale string) error {
+	mu.Lock()
+	prev := load()
+	if prev == nil {
+		mu.Unlock()
+		return errors.New("translator not initialized")
+	}
+	if locale == prev.defLocale {
+		mu.Unlock()
+		return errors.New("default locale cannot be removed")
+	}
+	if _, ok := prev.translators[locale]; !ok && !prev.lazy.has(locale) {
+		mu.Unlock()
+		return fmt.Errorf("locale %s not loaded", locale)
+	}
+
+	snap := prev.clone()
+	delete(snap.translators, locale)
+	snap.availableLocales = make([]string, 0, len(prev.availableLocales))
+	for _, available := range prev.availableLocales {
+		if available != locale {
+			snap.availableLocales = append(snap.availableLocales, available)
+		}
+	}
+	// Overlays are kept as on reload, they are applied again when locale is added back
+	for tenant, translators := range prev.tenants {
+		if _, ok := translators[locale]; ok {
+			snap.tenants[tenant] = translators.without(locale)
+		}
+	}
+	current.Store(snap)
+	if snap.lazy.has(locale) {
+		snap.lazy.remove(locale)
+	}
+	mu.Unlock()
+
+	emit(ChangeEvent{Op: OpRemoveLocale, Locale: locale})
+	return nil
+}
+
+// update Applies change to copy of locale dictionary, compiles it and publishes new snapshot
+func update(locale string, change func(dict *Dictionary) error) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	prev := load()
+	if prev == nil {
+		return errors.New("translator not initialized")
+	}
+	base, ok := prev.translators[locale]
+	if !ok {
+		if !prev.lazy.has(locale) {
+			return fmt.Errorf("locale %s not loaded", locale)
+		}
+		var err error
+		if base, err = prev.lazy.get(locale); err != nil {
+			return fmt.Errorf("locale %s: %w", locale, err)
+		}
+	}
+
+	dict := base.dictionary()
+	if err := change(dict); err != nil {
+		return err
+	}
+	tr, err := newTranslator(locale, dict)
+	if err != nil {
+		return err
+	}
+
+	// Changed lazily loaded locale stays loaded, so the change is not lost on unloading
+	snap := prev.clone()
+	snap.translators[locale] = tr
+	if err = snap.buildTenantLocale(locale); err != nil {
+		return err
+	}
+
+	current.Store(snap)
+	if !ok {
+		snap.lazy.invalidate(locale)
+	}
+	return nil
+}
+
+// without Returns collection copy without locale
+func (c TranslatorCollection) without(locale string) TranslatorCollection {
+	collection := make(TranslatorCollection, len(c))
+	for l, tr := range c {
+		if l != locale {
+			collection[l] = tr
+		}
+	}
+	return collection
+}
+
+// dictionary Returns dictionary with source strings of translator entries
+func (tr *Translator) dictionary() *Dictionary {
+	if tr.binary == nil {
+		return tr.messages.dictionary()
+	}
+	dict := tr.binary.dictionary()
+	for section, entry := range *tr.messages.dictionary() {
+		for key, value := range *entry {
+			dict.set(section, key, value)
+		}
+	}
+	return dict
+}
+
+// dictionary Returns dictionary with source strings of compiled entries
+func (c catalog) dictionary() *Dictionary {
+	dict := make(Dictionary, len(c))
+	for section, messages := range c {
+		entry := make(DictionaryEntry, len(messages))
+		for key, m := range messages {
+			entry[key] = m.source
+		}
+		dict[section] = &entry
+	}
+	return &dict
+}
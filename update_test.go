@@ -0,0 +1,193 @@
+package i18n
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	initOverlayDict(t)
+
+	var events []ChangeEvent
+	unsubscribe := OnChange(func(e ChangeEvent) {
+		events = append(events, e)
+	})
+	defer unsubscribe()
+
+	before := Get("en")
+	if err := Set("en", "product", "name", "Go i18n Pro"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Set("en", "errors", "unknown", "Unknown error"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := Get("en").T("support", "contact"); got != "Contact Go i18n Pro for everyone support" {
+		t.Errorf("Get().T() after Set() = %v", got)
+	}
+	if got := Get("en").T("errors", "unknown"); got != "Unknown error" {
+		t.Errorf("Get().T() for new section = %v", got)
+	}
+	if got := before.T("product", "name"); got != "Go i18n" {
+		t.Errorf("previous Translator changed by Set() = %v", got)
+	}
+
+	want := []ChangeEvent{
+		{Op: OpSet, Locale: "en", Section: "product", Key: "name", Value: "Go i18n Pro"},
+		{Op: OpSet, Locale: "en", Section: "errors", Key: "unknown", Value: "Unknown error"},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("OnChange() events = %v, want %v", events, want)
+	}
+}
+
+func TestSet_UpdatesOverlay(t *testing.T) {
+	initOverlayDict(t)
+
+	err := SetOverlay("acme", &DictionaryCollection{
+		"en": {"support": {"hours": "Call us {hours}"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Set("en", "product", "name", "Go i18n Pro"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := GetFor("acme", "en").T("product", "tagline"); got != "Go i18n Pro for everyone" {
+		t.Errorf("GetFor().T() after Set() = %v", got)
+	}
+	if got := GetFor("acme", "en").T("support", "hours"); got != "Call us {hours}" {
+		t.Errorf("GetFor().T() overlay entry after Set() = %v", got)
+	}
+}
+
+func TestUpdate_Errors(t *testing.T) {
+	initOverlayDict(t)
+
+	tests := []struct {
+		name   string
+		update func() error
+	}{
+		{
+			name:   "set unknown locale",
+			update: func() error { return Set("de", "product", "name", "Go i18n") },
+		},
+		{
+			name:   "set empty key",
+			update: func() error { return Set("en", "product", "", "Go i18n") },
+		},
+		{
+			name:   "set cyclic reference",
+			update: func() error { return Set("en", "product", "name", "{@product.tagline}") },
+		},
+		{
+			name:   "delete missing key",
+			update: func() error { return DeleteKey("en", "product", "unknown") },
+		},
+		{
+			name:   "add loaded locale",
+			update: func() error { return AddLocale("cz", &Dictionary{}) },
+		},
+		{
+			name:   "remove default locale",
+			update: func() error { return RemoveLocale(testDefaultLocale) },
+		},
+		{
+			name:   "remove unknown locale",
+			update: func() error { return RemoveLocale("de") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.update(); err == nil {
+				t.Error("update error = nil, want error")
+			}
+		})
+	}
+
+	if got := Get("en").T("product", "name"); got != "Go i18n" {
+		t.Errorf("Get().T() after failed updates = %v", got)
+	}
+}
+
+func TestAddRemoveLocale(t *testing.T) {
+	initOverlayDict(t)
+
+	if err := AddLocale("de", &Dictionary{"product": {"name": "Go i18n DE"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := Get("de").T("product", "name"); got != "Go i18n DE" {
+		t.Errorf("Get().T() after AddLocale() = %v", got)
+	}
+	if err := DeleteKey("de", "product", "name"); err != nil {
+		t.Fatal(err)
+	}
+	if got := Get("de").T("product", "name"); got != "product.name" {
+		t.Errorf("Get().T() after DeleteKey() = %v", got)
+	}
+
+	if err := RemoveLocale("de"); err != nil {
+		t.Fatal(err)
+	}
+	if got := Get("de").T("product", "name"); got != "Go i18n" {
+		t.Errorf("Get().T() after RemoveLocale() = %v, want default locale", got)
+	}
+	for _, locale := range AvailableLocales() {
+		if locale == "de" {
+			t.Error("AvailableLocales() contains removed locale")
+		}
+	}
+}
+
+func TestAddRemoveLocale_Overlay(t *testing.T) {
+	initOverlayDict(t)
+	defer RemoveOverlay("acme")
+
+	err := SetOverlay("acme", &DictionaryCollection{
+		"cz": {"product": {"name": "Acme Cloud"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Overlay is kept after removal and applied again when locale is added back
+	if err = RemoveLocale("cz"); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetFor("acme", "cz").T("product", "name"); got != "Go i18n" {
+		t.Errorf("GetFor().T() after RemoveLocale() = %v, want default locale", got)
+	}
+	if err = AddLocale("cz", &Dictionary{"product": {"name": "Go i18n CZ"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetFor("acme", "cz").T("product", "name"); got != "Acme Cloud" {
+		t.Errorf("GetFor().T() after AddLocale() = %v, want Acme Cloud", got)
+	}
+	if got := Get("cz").T("product", "name"); got != "Go i18n CZ" {
+		t.Errorf("Get().T() after AddLocale() = %v, want Go i18n CZ", got)
+	}
+}
+
+func TestSet_ConcurrentReaders(t *testing.T) {
+	initOverlayDict(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				_ = Get("en").T("support", "contact")
+			}
+		}()
+	}
+	for j := 0; j < 20; j++ {
+		if err := Set("en", "product", "name", "Go i18n"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+}
@@ -0,0 +1,312 @@
+package i18n
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultValidationSection Section of validation messages of fields without i18n tag
+const DefaultValidationSection = "errors.validation"
+
+// RuleFunc Reports whether value satisfies rule with param, e.g. "3" of "min=3"
+type RuleFunc func(value reflect.Value, param string) bool
+
+// rule Validation rule, lengthKey is message key for strings, slices and maps
+type rule struct {
+	key       string
+	lengthKey string
+	check     RuleFunc
+}
+
+var (
+	rulesMu sync.RWMutex
+	rules   = map[string]rule{
+		"min":   {key: "min", lengthKey: "min_length", check: checkMin},
+		"max":   {key: "max", lengthKey: "max_length", check: checkMax},
+		"len":   {key: "len", lengthKey: "len", check: checkLen},
+		"email": {key: "email", check: checkEmail},
+		"oneof": {key: "oneof", check: checkOneOf},
+	}
+)
+
+// RegisterRule Registers validation rule used in validate tags, failed rule adds error
+// with key and {name} value set to rule param. Built-in rules are required, omitempty, min,
+// max, len, email and oneof, registered rule replaces rule with the same name.
+func RegisterRule(name string, key string, check RuleFunc) {
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+
+	rules[name] = rule{key: key, check: check}
+}
+
+func getRule(name string) (rule, bool) {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+
+	r, ok := rules[name]
+	return r, ok
+}
+
+// ValidateStruct Validates struct fields by tags, returns nil when struct is valid.
+//
+//	type Signup struct {
+//		Username string `json:"username" validate:"required,min=3,max=32" i18n:"form.signup"`
+//	}
+//
+// Each failed rule adds error of field with rule key, e.g. "required" or "min_length",
+// in i18n tag section, or in section of parent struct field, or in DefaultValidationSection.
+// Rule param is passed as {rule} value, e.g. {"{min}": 3}. Fields are named by json tag,
+// nested structs and slices of structs are validated with paths like "items[2].qty".
+// Rules of empty strings, slices, maps and nil pointers are skipped unless field is required,
+// rules of zero numbers are checked, e.g. 0 fails "min=1", unless field has omitempty rule.
+// ValidateStruct panics when v is not a struct or tag has unknown rule.
+func ValidateStruct(v any) *I18nMultipleError {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("i18n: ValidateStruct of %T, want struct", v))
+	}
+
+	e := NewMultipleEmptyErr()
+	validateStruct(e, "", DefaultValidationSection, rv)
+	if !e.HasErrors() {
+		return nil
+	}
+	return e
+}
+
+func validateStruct(e *I18nMultipleError, path string, section string, rv reflect.Value) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := fieldName(sf)
+		if name == "-" {
+			continue
+		}
+		fieldSection := section
+		if tag, ok := sf.Tag.Lookup("i18n"); ok && tag != "" {
+			fieldSection = tag
+		}
+		validateField(e, joinField(path, name), fieldSection, rv.Field(i), sf.Tag.Get("validate"))
+	}
+}
+
+func validateField(e *I18nMultipleError, path string, section string, value reflect.Value, tag string) {
+	if tag != "" && tag != "-" {
+		if !validateRules(e, path, section, value, tag) {
+			return
+		}
+	}
+
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Struct:
+		validateStruct(e, path, section, value)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			item := value.Index(i)
+			for item.Kind() == reflect.Pointer && !item.IsNil() {
+				item = item.Elem()
+			}
+			if item.Kind() == reflect.Struct {
+				validateStruct(e, FieldPath(path, i), section, item)
+			}
+		}
+	}
+}
+
+// validateRules Adds errors of failed rules, returns false when required value is missing
+func validateRules(e *I18nMultipleError, path string, section string, value reflect.Value, tag string) bool {
+	names := strings.Split(tag, ",")
+	required, omitEmpty := false, false
+	for _, name := range names {
+		switch strings.TrimSpace(name) {
+		case "required":
+			required = true
+		case "omitempty":
+			omitEmpty = true
+		}
+	}
+	if isZero(value) && (required || omitEmpty || !isNumber(value)) {
+		if required {
+			e.Add(path, section, "required")
+		}
+		return false
+	}
+
+	value = indirect(value)
+	for _, item := range names {
+		name, param, _ := strings.Cut(strings.TrimSpace(item), "=")
+		if name == "required" || name == "omitempty" || name == "" {
+			continue
+		}
+		r, ok := getRule(name)
+		if !ok {
+			panic(fmt.Sprintf("i18n: unknown validation rule %q of field %s", name, path))
+		}
+		if r.check(value, param) {
+			continue
+		}
+		key := r.key
+		if r.lengthKey != "" && hasLength(value) {
+			key = r.lengthKey
+		}
+		if param == "" {
+			e.Add(path, section, key)
+		} else {
+			e.Add(path, section, key, M{"{" + name + "}": ruleParam(param)})
+		}
+	}
+	return true
+}
+
+// fieldName Returns name of json tag or field name
+func fieldName(sf reflect.StructField) string {
+	if tag, ok := sf.Tag.Lookup("json"); ok {
+		if name, _, _ := strings.Cut(tag, ","); name != "" {
+			return name
+		}
+	}
+	return sf.Name
+}
+
+// ruleParam Returns number param as int64 or float64, other params as is
+func ruleParam(param string) interface{} {
+	if n, err := strconv.ParseInt(param, 10, 64); err == nil {
+		return n
+	}
+	if f, err := strconv.ParseFloat(param, 64); err == nil {
+		return f
+	}
+	return param
+}
+
+func isZero(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil()
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	default:
+		return value.IsZero()
+	}
+}
+
+func isNumber(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func indirect(value reflect.Value) reflect.Value {
+	for (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) && !value.IsNil() {
+		value = value.Elem()
+	}
+	return value
+}
+
+func hasLength(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	default:
+		return false
+	}
+}
+
+// compare Returns -1, 0, 1 comparing length of strings, slices and maps
+// or number value with param, ok is false for other kinds or invalid param
+func compare(value reflect.Value, param string) (int, bool) {
+	var n float64
+	switch value.Kind() {
+	case reflect.String:
+		n = float64(len([]rune(value.String())))
+	case reflect.Slice, reflect.Array, reflect.Map:
+		n = float64(value.Len())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n = float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		n = value.Float()
+	default:
+		return 0, false
+	}
+	p, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return 0, false
+	}
+	switch {
+	case n < p:
+		return -1, true
+	case n > p:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
+func checkMin(value reflect.Value, param string) bool {
+	c, ok := compare(value, param)
+	return ok && c >= 0
+}
+
+func checkMax(value reflect.Value, param string) bool {
+	c, ok := compare(value, param)
+	return ok && c <= 0
+}
+
+func checkLen(value reflect.Value, param string) bool {
+	c, ok := compare(value, param)
+	return ok && c == 0
+}
+
+func checkEmail(value reflect.Value, _ string) bool {
+	if value.Kind() != reflect.String {
+		return false
+	}
+	addr, err := mail.ParseAddress(value.String())
+	return err == nil && addr.Address == value.String()
+}
+
+// checkOneOf Reports whether value is one of space separated param values
+func checkOneOf(value reflect.Value, param string) bool {
+	var s string
+	switch value.Kind() {
+	case reflect.String:
+		s = value.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s = strconv.FormatUint(value.Uint(), 10)
+	default:
+		return false
+	}
+	for _, allowed := range strings.Fields(param) {
+		if s == allowed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package i18n
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testAddress struct {
+	Street string `json:"street" validate:"required"`
+	Zip    string `json:"zip" validate:"len=5"`
+}
+
+type testItem struct {
+	SKU string `json:"sku" validate:"required,sku"`
+	Qty int    `json:"qty" validate:"min=1,max=99"`
+}
+
+type testSignup struct {
+	Username string       `json:"username" validate:"required,min=3,max=32" i18n:"form.signup"`
+	Email    string       `json:"email,omitempty" validate:"required,email"`
+	Role     string       `json:"role" validate:"oneof=admin user"`
+	Age      *int         `json:"age" validate:"min=18"`
+	Nickname string       `validate:"max=5"`
+	Address  *testAddress `json:"address" validate:"required" i18n:"form.address"`
+	Items    []testItem   `json:"items"`
+	Ignored  string       `json:"-" validate:"required"`
+	internal string
+}
+
+func TestValidateStruct(t *testing.T) {
+	RegisterRule("sku", "invalid_sku", func(value reflect.Value, _ string) bool {
+		return strings.HasPrefix(value.String(), "SKU-")
+	})
+
+	age := 16
+	tests := []struct {
+		name  string
+		value any
+		want  map[string][]*BaseError
+	}{
+		{
+			name: "valid",
+			value: &testSignup{
+				Username: "alice",
+				Email:    "alice@example.com",
+				Role:     "admin",
+				Address:  &testAddress{Street: "Main"},
+				Items:    []testItem{{SKU: "SKU-1", Qty: 2}},
+			},
+			want: nil,
+		},
+		{
+			name:  "required",
+			value: testSignup{},
+			want: map[string][]*BaseError{
+				"username": {{section: "form.signup", key: "required"}},
+				"email":    {{section: "errors.validation", key: "required"}},
+				"address":  {{section: "form.address", key: "required"}},
+			},
+		},
+		{
+			name: "rules",
+			value: &testSignup{
+				Username: "al",
+				Email:    "alice",
+				Role:     "root",
+				Age:      &age,
+				Nickname: "Bartholomew",
+				Address:  &testAddress{Zip: "123"},
+				Items:    []testItem{{SKU: "SKU-1", Qty: 1}, {SKU: "X", Qty: 100}},
+			},
+			want: map[string][]*BaseError{
+				"username":       {{section: "form.signup", key: "min_length", values: M{"{min}": int64(3)}}},
+				"email":          {{section: "errors.validation", key: "email"}},
+				"role":           {{section: "errors.validation", key: "oneof", values: M{"{oneof}": "admin user"}}},
+				"age":            {{section: "errors.validation", key: "min", values: M{"{min}": int64(18)}}},
+				"Nickname":       {{section: "errors.validation", key: "max_length", values: M{"{max}": int64(5)}}},
+				"address.street": {{section: "form.address", key: "required"}},
+				"address.zip":    {{section: "form.address", key: "len", values: M{"{len}": int64(5)}}},
+				"items[1].sku":   {{section: "errors.validation", key: "invalid_sku"}},
+				"items[1].qty":   {{section: "errors.validation", key: "max", values: M{"{max}": int64(99)}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateStruct(tt.value)
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("ValidateStruct() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("ValidateStruct() = nil")
+			}
+			if !reflect.DeepEqual(got.FieldErrors(), tt.want) {
+				t.Errorf("ValidateStruct() = %v, want %v", got, tt.want)
+			}
+			if got.Code() != 400 {
+				t.Errorf("ValidateStruct() code = %v, want 400", got.Code())
+			}
+		})
+	}
+}
+
+func TestValidateStruct_ZeroNumbers(t *testing.T) {
+	type order struct {
+		Qty      int     `json:"qty" validate:"min=1"`
+		Discount float64 `json:"discount" validate:"max=-1"`
+		Priority uint    `json:"priority" validate:"oneof=1 2 3"`
+		Count    int     `json:"count" validate:"len=2"`
+		Bonus    int     `json:"bonus" validate:"omitempty,min=10"`
+		Limit    int     `json:"limit" validate:"required,min=1"`
+		Offset   int     `json:"offset" validate:"max=100"`
+	}
+
+	got := ValidateStruct(order{})
+	want := map[string][]*BaseError{
+		"qty":      {{section: "errors.validation", key: "min", values: M{"{min}": int64(1)}}},
+		"discount": {{section: "errors.validation", key: "max", values: M{"{max}": int64(-1)}}},
+		"priority": {{section: "errors.validation", key: "oneof", values: M{"{oneof}": "1 2 3"}}},
+		"count":    {{section: "errors.validation", key: "len", values: M{"{len}": int64(2)}}},
+		"limit":    {{section: "errors.validation", key: "required"}},
+	}
+	if got == nil || !reflect.DeepEqual(got.FieldErrors(), want) {
+		t.Errorf("ValidateStruct() = %v, want %v", got, want)
+	}
+
+	if got = ValidateStruct(order{Qty: 1, Discount: -2, Priority: 2, Count: 2, Limit: 1}); got != nil {
+		t.Errorf("ValidateStruct() = %v, want nil", got)
+	}
+}
+
+func TestValidateStruct_Translated(t *testing.T) {
+	dict := &Dictionary{
+		"form.signup": {
+			"min_length": "{field} minimum length is {min}",
+		},
+		"fields": {
+			"username": "User name",
+		},
+	}
+	tr := testTranslator(t, "en", dict)
+
+	e := ValidateStruct(struct {
+		Username string `json:"username" validate:"min=3" i18n:"form.signup"`
+	}{Username: "al"})
+	if got := e.FieldMessages(tr)["username"]; len(got) != 1 || got[0] != "User name minimum length is 3" {
+		t.Errorf("FieldMessages() = %v", got)
+	}
+}
+
+func TestValidateStruct_Panics(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{"not struct", 1},
+		{"unknown rule", struct {
+			Name string `validate:"unknown"`
+		}{Name: "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("ValidateStruct() did not panic")
+				}
+			}()
+			ValidateStruct(tt.value)
+		})
+	}
+}
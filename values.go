@@ -0,0 +1,131 @@
+package i18n
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// jsonValues Values of full JSON form, typed arguments are encoded as objects with "$" names,
+// so they are restored with their type:
+//
+//	Int(-5)            {"$int": -5}
+//	Float(0.5, 2)      {"$float": 0.5, "$precision": 2}
+//	Money(1299, "EUR") {"$money": 1299, "$currency": "EUR"}
+//	Date(t)            {"$date": "2024-03-07T10:00:00Z"}
+//	Msg(s, k, v)       {"$msg": {"s": section, "k": key, "v": values}}
+type jsonValues map[string]interface{}
+
+// jsonArg Encoded typed argument, see jsonValues
+type jsonArg struct {
+	Int       *int64     `json:"$int,omitempty"`
+	Float     *float64   `json:"$float,omitempty"`
+	Precision int        `json:"$precision,omitempty"`
+	Money     *int64     `json:"$money,omitempty"`
+	Currency  string     `json:"$currency,omitempty"`
+	Date      *time.Time `json:"$date,omitempty"`
+	Msg       *errorFull `json:"$msg,omitempty"`
+}
+
+func (v jsonValues) MarshalJSON() ([]byte, error) {
+	encoded := make(map[string]interface{}, len(v))
+	for name, value := range v {
+		encoded[name] = valueToJSON(value)
+	}
+	return json.Marshal(encoded)
+}
+
+func (v *jsonValues) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		*v = nil
+		return nil
+	}
+	values := make(jsonValues, len(raw))
+	for name, rawValue := range raw {
+		value, err := valueFromJSON(rawValue)
+		if err != nil {
+			return err
+		}
+		values[name] = value
+	}
+	*v = values
+	return nil
+}
+
+// valueToJSON Returns typed argument as jsonArg, other values as is
+func valueToJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case intArg:
+		n := int64(v.abs)
+		if v.negative {
+			n = -n
+		}
+		return jsonArg{Int: &n}
+	case floatArg:
+		return jsonArg{Float: &v.value, Precision: v.precision}
+	case moneyArg:
+		return jsonArg{Money: &v.amount, Currency: v.currency}
+	case dateArg:
+		t := time.Time(v)
+		return jsonArg{Date: &t}
+	case Message:
+		return jsonArg{Msg: &errorFull{
+			Section: v.section,
+			Key:     v.key,
+			Values:  jsonValues(v.values),
+		}}
+	default:
+		return value
+	}
+}
+
+// valueFromJSON Returns typed argument of jsonArg, other values as decoded by encoding/json
+func valueFromJSON(raw json.RawMessage) (interface{}, error) {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	if obj, ok := value.(map[string]interface{}); !ok || !isJSONArg(obj) {
+		return value, nil
+	}
+
+	var arg jsonArg
+	if err := json.Unmarshal(raw, &arg); err != nil {
+		return nil, err
+	}
+	switch {
+	case arg.Int != nil:
+		return Int(*arg.Int), nil
+	case arg.Float != nil:
+		return Float(*arg.Float, arg.Precision), nil
+	case arg.Money != nil:
+		return Money(*arg.Money, arg.Currency), nil
+	case arg.Date != nil:
+		return Date(*arg.Date), nil
+	case arg.Msg != nil:
+		return Message{
+			section: arg.Msg.Section,
+			key:     arg.Msg.Key,
+			values:  M(arg.Msg.Values),
+		}, nil
+	default:
+		return value, nil
+	}
+}
+
+// isJSONArg Checks all names of object start with "$"
+func isJSONArg(obj map[string]interface{}) bool {
+	if len(obj) == 0 {
+		return false
+	}
+	for name := range obj {
+		if !strings.HasPrefix(name, "$") {
+			return false
+		}
+	}
+	return true
+}